@@ -0,0 +1,11 @@
+# Query all Blocks in the Workspace set in the provider
+data "prefect_blocks" "all" {}
+
+# Query all Secret Blocks, and read one of them by name
+data "prefect_blocks" "secrets" {
+  type_slug = "secret"
+}
+
+data "prefect_block" "first_secret" {
+  id = data.prefect_blocks.secrets.blocks[0].id
+}
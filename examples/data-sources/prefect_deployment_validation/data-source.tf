@@ -0,0 +1,23 @@
+# Validate a Deployment's configuration before applying it
+data "prefect_deployment_validation" "etl" {
+  flow_id    = "00000000-0000-0000-0000-000000000000"
+  entrypoint = "flows/etl.py:etl"
+  parameters = jsonencode({
+    region = "us-east-1"
+  })
+  parameter_openapi_schema = jsonencode({
+    type = "object"
+    properties = {
+      region = { type = "string" }
+    }
+    required = ["region"]
+  })
+}
+
+# Fail the plan with the validation messages
+check "etl_deployment" {
+  assert {
+    condition     = data.prefect_deployment_validation.etl.valid
+    error_message = join("\n", data.prefect_deployment_validation.etl.messages)
+  }
+}
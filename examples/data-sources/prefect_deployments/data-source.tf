@@ -0,0 +1,13 @@
+# Query all Deployments in the Workspace set in the provider
+data "prefect_deployments" "all" {}
+
+# Query the Deployments of a Flow that have all of the given tags
+data "prefect_deployments" "dashboard" {
+  flow_id = "00000000-0000-0000-0000-000000000000"
+  tags    = ["dashboard"]
+}
+
+# Query Deployments by name
+data "prefect_deployments" "by_name" {
+  name = "my-deployment"
+}
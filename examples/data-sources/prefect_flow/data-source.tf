@@ -0,0 +1,10 @@
+data "prefect_flow" "existing_by_name" {
+  name         = "my-flow"
+  workspace_id = "00000000-0000-0000-0000-000000000000"
+}
+
+resource "prefect_deployment" "deployment" {
+  name         = "my-deployment"
+  flow_id      = data.prefect_flow.existing_by_name.id
+  workspace_id = "00000000-0000-0000-0000-000000000000"
+}
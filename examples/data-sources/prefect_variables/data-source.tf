@@ -0,0 +1,12 @@
+# Query all Variables in the Workspace set in the provider
+data "prefect_variables" "all" {}
+
+# Copy all Variables from one Workspace into another
+data "prefect_variables" "source" {
+  workspace_id = "00000000-0000-0000-0000-000000000000"
+}
+
+resource "prefect_variables" "target" {
+  workspace_id = "11111111-1111-1111-1111-111111111111"
+  variables    = data.prefect_variables.source.values
+}
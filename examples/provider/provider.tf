@@ -27,6 +27,33 @@ provider "prefect" {
   workspace_id = var.prefect_workspace_id
 }
 
+# Deployments that don't set `paused` can follow a per-workspace
+# default, e.g. to start deployments paused in production only.
+provider "prefect" {
+  api_key    = var.prefect_api_key
+  account_id = var.prefect_account_id
+  default_paused_by_workspace = {
+    (var.prefect_prod_workspace_id) = true
+    (var.prefect_dev_workspace_id)  = false
+  }
+}
+
+# When applying many resources in parallel, you can limit the
+# number of requests per second sent to the Prefect API.
+provider "prefect" {
+  api_key    = var.prefect_api_key
+  account_id = var.prefect_account_id
+  rate_limit = 10
+}
+
+# Requests to the Prefect API time out after 60 seconds by default,
+# which can be raised for slow networks, or disabled with 0.
+provider "prefect" {
+  api_key         = var.prefect_api_key
+  account_id      = var.prefect_account_id
+  request_timeout = 120
+}
+
 # Finally, in rare occasions, you also have the option
 # to point the provider to a locally running Prefect Server,
 # with a limited set of functionality from the provider.
@@ -1,6 +1,6 @@
 resource "prefect_account" "example" {
-  name          = "My Imported Account"
-  description   = "A cool account"
+  name          = "My Account"
+  handle        = "my-account"
   billing_email = "marvin@prefect.io"
   settings = {
     allow_public_workspaces = true
@@ -69,3 +69,30 @@ resource "prefect_block" "my_dbt_run_operation_block" {
     "dbt_cli_profile" = { "$ref" : { "block_document_id" : prefect_block.my_dbt_cli_profile.id } }
   })
 }
+
+# example:
+# a "$ref" can also point to a block by its type slug and name,
+# which is resolved to the block's ID at apply time
+resource "prefect_block" "my_dbt_run_operation_block_by_name" {
+  name      = "my-dbt-operations-by-name"
+  type_slug = "dbt-core-operation"
+
+  data = jsonencode({
+    "commands"        = ["dbt deps", "dbt seed", "dbt run"]
+    "dbt_cli_profile" = { "$ref" : { "block_type_slug" : "dbt-cli-profile", "block_document_name" : "my-dbt-cli-profile" } }
+  })
+
+  depends_on = [prefect_block.my_dbt_cli_profile]
+}
+
+# example:
+# anonymous blocks are named by the server and are
+# typically used as system-managed configuration, e.g. for storage
+resource "prefect_block" "anonymous_secret" {
+  is_anonymous = true
+  type_slug    = "secret"
+
+  data = jsonencode({
+    "value" = "bar"
+  })
+}
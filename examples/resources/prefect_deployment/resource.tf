@@ -18,10 +18,26 @@ resource "prefect_deployment" "deployment" {
   tags                     = ["test"]
   enforce_parameter_schema = false
   manifest_path            = "./bar/foo"
+  concurrency_limit        = 1
+  concurrency_options = {
+    collision_strategy = "ENQUEUE"
+  }
   parameters = jsonencode({
     "some-parameter" : "some-value",
     "some-parameter2" : "some-value2"
   })
+  job_variables = jsonencode({
+    "image" : "prefecthq/prefect:3-latest",
+    "env" : { "PREFECT_LOGGING_LEVEL" : "DEBUG" }
+  })
+  pull_steps = jsonencode([
+    {
+      "prefect.deployments.steps.git_clone" : {
+        "repository" : "https://github.com/PrefectHQ/prefect.git",
+        "branch" : "main"
+      }
+    }
+  ])
   path            = "./foo/bar"
   paused          = false
   version         = "v1.1.1"
@@ -29,3 +45,19 @@ resource "prefect_deployment" "deployment" {
   work_queue_name = "default"
 }
 
+
+# Older deployments can load their flow code from a storage Block,
+# referenced by name with the prefect_block data source
+data "prefect_block" "storage" {
+  name         = "my-storage"
+  type_slug    = "s3-bucket"
+  workspace_id = prefect_workspace.workspace.id
+}
+
+resource "prefect_deployment" "block_storage" {
+  name                = "my-block-storage-deployment"
+  workspace_id        = prefect_workspace.workspace.id
+  flow_id             = prefect_flow.flow.id
+  entrypoint          = "hello_world.py:hello_world"
+  storage_document_id = data.prefect_block.storage.id
+}
@@ -0,0 +1,14 @@
+resource "prefect_deployment_sla" "example" {
+  deployment_id = prefect_deployment.example.id
+  slas = [
+    {
+      name     = "runs-longer-than-10-minutes"
+      duration = 600
+    },
+    {
+      name     = "runs-longer-than-an-hour"
+      duration = 3600
+      severity = "critical"
+    },
+  ]
+}
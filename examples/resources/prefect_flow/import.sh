@@ -1,2 +1,5 @@
 # Prefect Flows can be imported via flow_id,workspace_id
 terraform import prefect_flow.example 00000000-0000-0000-0000-000000000000,00000000-0000-0000-0000-000000000000
+
+# Prefect Flows can also be imported via flow_name,workspace_id
+terraform import prefect_flow.example my-flow,00000000-0000-0000-0000-000000000000
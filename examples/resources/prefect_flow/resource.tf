@@ -7,5 +7,8 @@ resource "prefect_flow" "flow" {
   name         = "my-flow"
   workspace_id = prefect_workspace.workspace.id
   tags         = ["tf-test"]
+  labels       = {
+    "team" = "data-platform"
+  }
 }
 
@@ -0,0 +1,13 @@
+resource "prefect_global_concurrency_limit" "example" {
+  name   = "database-connections"
+  limit  = 10
+  active = true
+}
+
+# Global concurrency limits can also be used as rate limits,
+# by releasing occupied slots over time
+resource "prefect_global_concurrency_limit" "rate_limit" {
+  name                  = "external-api"
+  limit                 = 5
+  slot_decay_per_second = 1.0
+}
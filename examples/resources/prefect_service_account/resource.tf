@@ -15,3 +15,12 @@ resource "prefect_service_account" "example" {
   name               = "my-service-account"
   api_key_expiration = time_rotating.ninety_days.rotation_rfc3339
 }
+
+# ON-DEMAND API KEY ROTATION
+# Change any value in `api_key_keepers` to rotate the key
+resource "prefect_service_account" "example" {
+  name = "my-service-account"
+  api_key_keepers = {
+    rotation = "2024-01"
+  }
+}
@@ -0,0 +1,15 @@
+# prefect_tag_concurrency_limit resources can be imported by the `tag/name_of_tag` identifier
+terraform import prefect_tag_concurrency_limit.example tag/name_of_tag
+
+# Alternatively, they can be imported by the concurrency limit's ID
+terraform import prefect_tag_concurrency_limit.example 00000000-0000-0000-0000-000000000000
+
+# Pass an optional, comma-separated value following the identifier
+# if you need to import a resource in a different workspace
+# from the one that your provider is configured with
+# NOTE: you must specify the workspace_id attribute in the addressed resource
+#
+# tag/<tag>,<workspace_id>
+terraform import prefect_tag_concurrency_limit.example tag/name_of_tag,11111111-1111-1111-1111-111111111111
+# <concurrency_limit_id>,<workspace_id>
+terraform import prefect_tag_concurrency_limit.example 00000000-0000-0000-0000-000000000000,11111111-1111-1111-1111-111111111111
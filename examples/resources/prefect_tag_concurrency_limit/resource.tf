@@ -0,0 +1,5 @@
+# Allow at most 10 task runs tagged "database" to run at once
+resource "prefect_tag_concurrency_limit" "database" {
+  tag               = "database"
+  concurrency_limit = 10
+}
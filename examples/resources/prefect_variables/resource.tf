@@ -0,0 +1,8 @@
+resource "prefect_variables" "example" {
+  variables = {
+    "environment"    = jsonencode("production")
+    "slack_channel"  = jsonencode("#data-alerts")
+    "retention_days" = jsonencode(30)
+    "owners"         = jsonencode(["data-platform", "analytics"])
+  }
+}
@@ -0,0 +1,8 @@
+# prefect_webhook resources can be imported by the webhook's ID
+terraform import prefect_webhook.example 00000000-0000-0000-0000-000000000000
+
+# Pass an optional, comma-separated value following the identifier
+# if you need to import a resource in a different workspace
+# from the one that your provider is configured with
+# NOTE: you must specify the workspace_id attribute in the addressed resource
+terraform import prefect_webhook.example 00000000-0000-0000-0000-000000000000,11111111-1111-1111-1111-111111111111
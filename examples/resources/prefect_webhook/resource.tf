@@ -0,0 +1,16 @@
+resource "prefect_webhook" "example" {
+  name        = "my-webhook"
+  description = "Turns GitHub pushes into Prefect events"
+  enabled     = true
+  template = jsonencode({
+    event = "github.push"
+    resource = {
+      "prefect.resource.id" = "github.repository.{{ body.repository.full_name }}"
+    }
+  })
+}
+
+# The generated URL to send requests to
+output "webhook_endpoint" {
+  value = prefect_webhook.example.endpoint
+}
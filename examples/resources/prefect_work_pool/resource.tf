@@ -25,3 +25,20 @@ resource "prefect_work_pool" "example" {
   paused            = false
   base_job_template = data.prefect_worker_metadata.d.base_job_configs.kubernetes
 }
+
+# Or start from the default base job configuration,
+# overriding only the values that differ
+resource "prefect_work_pool" "example" {
+  name              = "test-k8s-pool"
+  type              = "kubernetes"
+  workspace_id      = data.prefect_workspace.prd.id
+  base_job_template = data.prefect_worker_metadata.d.base_job_configs.kubernetes
+  base_job_template_overrides = jsonencode({
+    variables = {
+      properties = {
+        image     = { default = "prefecthq/prefect:3-latest" }
+        namespace = { default = "prefect" }
+      }
+    }
+  })
+}
@@ -1,2 +1,6 @@
 # Prefect Workspace Roles can be imported using the workspace role's UUID
 terraform import prefect_workspace_role.example 00000000-0000-0000-0000-000000000000
+
+# Built-in Workspace Roles can be imported the same way, to reference them,
+# but they can't be updated or deleted
+terraform import prefect_workspace_role.viewer 00000000-0000-0000-0000-000000000000
@@ -5,3 +5,13 @@ resource "prefect_workspace_role" "example" {
     "see_flows"
   ]
 }
+
+# Permissions can also be set as an unordered set,
+# so that reordering them isn't planned as a change
+resource "prefect_workspace_role" "unordered" {
+  name = "Unordered Workspace Role"
+  permissions = [
+    "see_flows",
+    "manage_blocks"
+  ]
+}
@@ -2,8 +2,13 @@ package api
 
 import (
 	"context"
+	"errors"
 )
 
+// ErrAccountHandleTaken is returned when creating an account whose handle
+// is already used by another account.
+var ErrAccountHandleTaken = errors.New("account handle is already taken")
+
 // AccountsClient is a client for working with accounts.
 type AccountsClient interface {
 	Get(ctx context.Context) (*AccountResponse, error)
@@ -12,6 +17,12 @@ type AccountsClient interface {
 	Delete(ctx context.Context) error
 }
 
+// AccountCreationClient is a client for creating accounts, which, unlike
+// working with an existing account, doesn't need an account ID.
+type AccountCreationClient interface {
+	Create(ctx context.Context, data AccountCreate) (*AccountResponse, error)
+}
+
 // AccountSettings is a representation of an account's settings.
 type AccountSettings struct {
 	AllowPublicWorkspaces bool `json:"allow_public_workspaces"`
@@ -49,6 +60,13 @@ type AccountResponse struct {
 	Features              []string `json:"features"`
 }
 
+// AccountCreate is the data sent when creating an account.
+type AccountCreate struct {
+	Name     string           `json:"name"`
+	Handle   string           `json:"handle"`
+	Settings *AccountSettings `json:"settings,omitempty"`
+}
+
 // AccountUpdate is the data sent when updating an account.
 type AccountUpdate struct {
 	Name                  *string `json:"name"`
@@ -0,0 +1,9 @@
+package api
+
+import "context"
+
+// AdminClient is a client for working with the server's administrative endpoints.
+type AdminClient interface {
+	// Version returns the version of the Prefect server, e.g. `3.1.0`.
+	Version(ctx context.Context) (string, error)
+}
@@ -9,6 +9,7 @@ import (
 type BlockDocumentClient interface {
 	Get(ctx context.Context, id uuid.UUID) (*BlockDocument, error)
 	GetByName(ctx context.Context, typeSlug, name string) (*BlockDocument, error)
+	List(ctx context.Context, typeSlugs []string) ([]*BlockDocument, error)
 	Create(ctx context.Context, payload BlockDocumentCreate) (*BlockDocument, error)
 	Update(ctx context.Context, id uuid.UUID, payload BlockDocumentUpdate) error
 	Delete(ctx context.Context, id uuid.UUID) error
@@ -19,8 +20,9 @@ type BlockDocumentClient interface {
 
 type BlockDocument struct {
 	BaseModel
-	Name string                 `json:"name"`
-	Data map[string]interface{} `json:"data"`
+	Name        string                 `json:"name"`
+	Data        map[string]interface{} `json:"data"`
+	IsAnonymous bool                   `json:"is_anonymous"`
 
 	BlockSchemaID uuid.UUID    `json:"block_schema_id"`
 	BlockSchema   *BlockSchema `json:"block_schema"`
@@ -28,13 +30,59 @@ type BlockDocument struct {
 	BlockTypeID   uuid.UUID `json:"block_type_id"`
 	BlockTypeName *string   `json:"block_type_name"`
 	BlockType     BlockType `json:"block_type"`
+
+	// BlockDocumentReferences are the Blocks referenced in Data, keyed by
+	// the field referencing them. The API returns their data inline in Data.
+	BlockDocumentReferences map[string]BlockDocumentReference `json:"block_document_references"`
+}
+
+// BlockDocumentReference is a Block referenced by a field of a block document.
+type BlockDocumentReference struct {
+	BlockDocument BlockDocumentReferenceTarget `json:"block_document"`
+}
+
+// BlockDocumentReferenceTarget is the subset of the referenced Block
+// returned in a block document's references.
+type BlockDocumentReferenceTarget struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	BlockType BlockType `json:"block_type"`
+}
+
+// BlockDocumentFilterSettings defines settings when searching for block documents.
+type BlockDocumentFilterSettings struct {
+	BlockDocuments *BlockDocumentFilter          `json:"block_documents,omitempty"`
+	BlockTypes     *BlockDocumentFilterBlockType `json:"block_types,omitempty"`
+	IncludeSecrets bool                          `json:"include_secrets"`
+}
+
+// BlockDocumentFilter defines filters on the block documents themselves.
+type BlockDocumentFilter struct {
+	IsAnonymous *BlockDocumentFilterIsAnonymous `json:"is_anonymous,omitempty"`
+}
+
+// BlockDocumentFilterIsAnonymous defines filter criteria on whether
+// block documents are anonymous.
+type BlockDocumentFilterIsAnonymous struct {
+	Eq bool `json:"eq_"`
+}
+
+// BlockDocumentFilterBlockType defines filters on the block type of block documents.
+type BlockDocumentFilterBlockType struct {
+	Slug *BlockDocumentFilterBlockTypeSlug `json:"slug,omitempty"`
+}
+
+// BlockDocumentFilterBlockTypeSlug defines filter criteria searching on block type slugs.
+type BlockDocumentFilterBlockTypeSlug struct {
+	Any []string `json:"any_"`
 }
 
 type BlockDocumentCreate struct {
-	Name          string                 `json:"name"`
+	Name          string                 `json:"name,omitempty"`
 	Data          map[string]interface{} `json:"data"`
 	BlockSchemaID uuid.UUID              `json:"block_schema_id"`
 	BlockTypeID   uuid.UUID              `json:"block_type_id"`
+	IsAnonymous   bool                   `json:"is_anonymous"`
 }
 
 type BlockDocumentUpdate struct {
@@ -7,6 +7,8 @@ import "github.com/google/uuid"
 //nolint:interfacebloat // we'll accept a larger PrefectClient interface
 type PrefectClient interface {
 	Accounts(accountID uuid.UUID) (AccountsClient, error)
+	AccountCreation() (AccountCreationClient, error)
+	Admin() (AdminClient, error)
 	AccountMemberships(accountID uuid.UUID) (AccountMembershipsClient, error)
 	AccountRoles(accountID uuid.UUID) (AccountRolesClient, error)
 	BlockDocuments(accountID uuid.UUID, workspaceID uuid.UUID) (BlockDocumentClient, error)
@@ -14,12 +16,17 @@ type PrefectClient interface {
 	BlockTypes(accountID uuid.UUID, workspaceID uuid.UUID) (BlockTypeClient, error)
 	Collections() (CollectionsClient, error)
 	Deployments(accountID uuid.UUID, workspaceID uuid.UUID) (DeploymentsClient, error)
+	DeploymentSLAs(accountID uuid.UUID, workspaceID uuid.UUID) (DeploymentSLAsClient, error)
+	TagConcurrencyLimits(accountID uuid.UUID, workspaceID uuid.UUID) (TagConcurrencyLimitsClient, error)
 	Teams(accountID uuid.UUID) (TeamsClient, error)
 	Flows(accountID uuid.UUID, workspaceID uuid.UUID) (FlowsClient, error)
+	FlowRunNotificationPolicies(accountID uuid.UUID, workspaceID uuid.UUID) (FlowRunNotificationPoliciesClient, error)
+	GlobalConcurrencyLimits(accountID uuid.UUID, workspaceID uuid.UUID) (GlobalConcurrencyLimitsClient, error)
 	Workspaces(accountID uuid.UUID) (WorkspacesClient, error)
 	WorkspaceAccess(accountID uuid.UUID, workspaceID uuid.UUID) (WorkspaceAccessClient, error)
 	WorkspaceRoles(accountID uuid.UUID) (WorkspaceRolesClient, error)
 	WorkPools(accountID uuid.UUID, workspaceID uuid.UUID) (WorkPoolsClient, error)
 	Variables(accountID uuid.UUID, workspaceID uuid.UUID) (VariablesClient, error)
+	Webhooks(accountID uuid.UUID, workspaceID uuid.UUID) (WebhooksClient, error)
 	ServiceAccounts(accountID uuid.UUID) (ServiceAccountsClient, error)
 }
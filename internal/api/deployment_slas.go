@@ -0,0 +1,32 @@
+package api
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// DeploymentSLAsClient is a client for working with the SLAs of a deployment.
+type DeploymentSLAsClient interface {
+	List(ctx context.Context, deploymentID uuid.UUID) ([]*DeploymentSLA, error)
+	Create(ctx context.Context, deploymentID uuid.UUID, data DeploymentSLAUpsert) (*DeploymentSLA, error)
+	Update(ctx context.Context, deploymentID uuid.UUID, slaID uuid.UUID, data DeploymentSLAUpsert) error
+	Delete(ctx context.Context, deploymentID uuid.UUID, slaID uuid.UUID) error
+}
+
+// DeploymentSLA is a representation of a deployment SLA, which fires an
+// alert when a flow run of the deployment runs for longer than its duration.
+type DeploymentSLA struct {
+	BaseModel
+	Name     string `json:"name"`
+	Duration int64  `json:"duration"`
+	Severity string `json:"severity"`
+}
+
+// DeploymentSLAUpsert defines the request payload
+// when creating or updating a deployment SLA.
+type DeploymentSLAUpsert struct {
+	Name     string `json:"name"`
+	Duration int64  `json:"duration"`
+	Severity string `json:"severity"`
+}
@@ -10,9 +10,10 @@ import (
 type DeploymentsClient interface {
 	Create(ctx context.Context, data DeploymentCreate) (*Deployment, error)
 	Get(ctx context.Context, deploymentID uuid.UUID) (*Deployment, error)
-	List(ctx context.Context, handleNames []string) ([]*Deployment, error)
+	List(ctx context.Context, filter DeploymentFilter) ([]*Deployment, error)
 	Update(ctx context.Context, deploymentID uuid.UUID, data DeploymentUpdate) error
 	Delete(ctx context.Context, deploymentID uuid.UUID) error
+	SetPaused(ctx context.Context, deploymentID uuid.UUID, paused bool) error
 }
 
 // Deployment is a representation of a deployment.
@@ -21,58 +22,149 @@ type Deployment struct {
 	AccountID   uuid.UUID `json:"account_id"`
 	WorkspaceID uuid.UUID `json:"workspace_id"`
 
+	ConcurrencyLimit       *int64                 `json:"concurrency_limit"`
+	ConcurrencyOptions     *ConcurrencyOptions    `json:"concurrency_options"`
 	Description            string                 `json:"description,omitempty"`
 	EnforceParameterSchema bool                   `json:"enforce_parameter_schema"`
 	Entrypoint             string                 `json:"entrypoint"`
 	FlowID                 uuid.UUID              `json:"flow_id"`
+	JobVariables           map[string]interface{} `json:"job_variables,omitempty"`
 	ManifestPath           string                 `json:"manifest_path,omitempty"`
 	Name                   string                 `json:"name"`
+	ParameterOpenAPISchema map[string]interface{} `json:"parameter_openapi_schema,omitempty"`
 	Parameters             map[string]interface{} `json:"parameters,omitempty"`
 	Path                   string                 `json:"path"`
 	Paused                 bool                   `json:"paused"`
+	PullSteps              []PullStep             `json:"pull_steps"`
 	Tags                   []string               `json:"tags"`
 	Version                string                 `json:"version,omitempty"`
+	VersionInfo            *VersionInfo           `json:"version_info,omitempty"`
 	WorkPoolName           string                 `json:"work_pool_name,omitempty"`
 	WorkQueueName          string                 `json:"work_queue_name,omitempty"`
+	WorkQueueID            *uuid.UUID             `json:"work_queue_id,omitempty"`
+
+	StorageDocumentID        *uuid.UUID `json:"storage_document_id"`
+	InfrastructureDocumentID *uuid.UUID `json:"infrastructure_document_id"`
+
+	// UpdatedBy is only reported by Prefect Cloud.
+	UpdatedBy *UpdatedBy `json:"updated_by,omitempty"`
+}
+
+// UpdatedBy identifies the actor that last updated an object, e.g. a user or a service account.
+type UpdatedBy struct {
+	ID           *uuid.UUID `json:"id"`
+	Type         string     `json:"type"`
+	DisplayValue string     `json:"display_value"`
 }
 
 // DeploymentCreate is a subset of Deployment used when creating deployments.
 type DeploymentCreate struct {
+	ConcurrencyLimit       *int64                 `json:"concurrency_limit,omitempty"`
+	ConcurrencyOptions     *ConcurrencyOptions    `json:"concurrency_options,omitempty"`
 	Description            string                 `json:"description,omitempty"`
 	EnforceParameterSchema bool                   `json:"enforce_parameter_schema,omitempty"`
 	Entrypoint             string                 `json:"entrypoint,omitempty"`
 	FlowID                 uuid.UUID              `json:"flow_id"`
+	JobVariables           map[string]interface{} `json:"job_variables,omitempty"`
 	ManifestPath           string                 `json:"manifest_path,omitempty"`
 	Name                   string                 `json:"name"`
+	ParameterOpenAPISchema map[string]interface{} `json:"parameter_openapi_schema,omitempty"`
 	Parameters             map[string]interface{} `json:"parameters,omitempty"`
 	Path                   string                 `json:"path,omitempty"`
 	Paused                 bool                   `json:"paused,omitempty"`
+	PullSteps              []PullStep             `json:"pull_steps,omitempty"`
 	Tags                   []string               `json:"tags,omitempty"`
 	Version                string                 `json:"version,omitempty"`
+	VersionInfo            *VersionInfo           `json:"version_info,omitempty"`
 	WorkPoolName           string                 `json:"work_pool_name,omitempty"`
 	WorkQueueName          string                 `json:"work_queue_name,omitempty"`
+
+	StorageDocumentID        *uuid.UUID `json:"storage_document_id,omitempty"`
+	InfrastructureDocumentID *uuid.UUID `json:"infrastructure_document_id,omitempty"`
 }
 
 // DeploymentUpdate is a subset of Deployment used when updating deployments.
+// Fields left as nil are not sent, so only the changed values are updated.
+//
+// ConcurrencyLimit, ConcurrencyOptions and the block document IDs are cleared
+// server-side with a null value, so they're double pointers: nil leaves them
+// out, and a pointer to nil sends an explicit null.
 type DeploymentUpdate struct {
-	Description            string                 `json:"description,omitempty"`
-	EnforceParameterSchema bool                   `json:"enforce_parameter_schema,omitempty"`
-	Entrypoint             string                 `json:"entrypoint,omitempty"`
-	ManifestPath           string                 `json:"manifest_path"`
-	Parameters             map[string]interface{} `json:"parameters,omitempty"`
-	Path                   string                 `json:"path,omitempty"`
-	Paused                 bool                   `json:"paused,omitempty"`
-	Tags                   []string               `json:"tags,omitempty"`
-	Version                string                 `json:"version,omitempty"`
-	WorkPoolName           string                 `json:"work_pool_name,omitempty"`
-	WorkQueueName          string                 `json:"work_queue_name,omitempty"`
+	ConcurrencyLimit       **int64                 `json:"concurrency_limit,omitempty"`
+	ConcurrencyOptions     **ConcurrencyOptions    `json:"concurrency_options,omitempty"`
+	Description            *string                 `json:"description,omitempty"`
+	EnforceParameterSchema *bool                   `json:"enforce_parameter_schema,omitempty"`
+	Entrypoint             *string                 `json:"entrypoint,omitempty"`
+	JobVariables           *map[string]interface{} `json:"job_variables,omitempty"`
+	ManifestPath           *string                 `json:"manifest_path,omitempty"`
+	ParameterOpenAPISchema *map[string]interface{} `json:"parameter_openapi_schema,omitempty"`
+	Parameters             *map[string]interface{} `json:"parameters,omitempty"`
+	Path                   *string                 `json:"path,omitempty"`
+	Paused                 *bool                   `json:"paused,omitempty"`
+	PullSteps              *[]PullStep             `json:"pull_steps,omitempty"`
+	Tags                   *[]string               `json:"tags,omitempty"`
+	Version                *string                 `json:"version,omitempty"`
+	VersionInfo            *VersionInfo            `json:"version_info,omitempty"`
+	WorkPoolName           *string                 `json:"work_pool_name,omitempty"`
+	WorkQueueName          *string                 `json:"work_queue_name,omitempty"`
+
+	StorageDocumentID        **uuid.UUID `json:"storage_document_id,omitempty"`
+	InfrastructureDocumentID **uuid.UUID `json:"infrastructure_document_id,omitempty"`
 }
 
+// ConcurrencyOptions configures how a deployment handles
+// runs beyond its concurrency limit.
+type ConcurrencyOptions struct {
+	CollisionStrategy string `json:"collision_strategy"`
+}
+
+// VersionInfo describes where a deployment's version came from.
+// For Git, Version holds the commit SHA.
+type VersionInfo struct {
+	Type    string `json:"type"`
+	Version string `json:"version"`
+	Branch  string `json:"branch,omitempty"`
+	URL     string `json:"url,omitempty"`
+}
+
+// PullStep is a single step describing how a deployment's flow code
+// is retrieved, e.g. {"prefect.deployments.steps.git_clone": {...}}.
+type PullStep map[string]interface{}
+
 // DeploymentFilter defines the search filter payload
-// when searching for deployements by name.
+// when searching for deployments, e.g. by flow, name or tags.
 // example request payload:
-// {"deployments": {"handle": {"any_": ["test"]}}}.
+// {"flows": {"id": {"any_": ["<uuid>"]}}, "deployments": {"tags": {"all_": ["test"]}}}.
 type DeploymentFilter struct {
+	Flows       *DeploymentFilterFlows       `json:"flows,omitempty"`
+	Deployments *DeploymentFilterDeployments `json:"deployments,omitempty"`
+}
+
+// DeploymentFilterFlows defines filter criteria searching on
+// the flows of deployments.
+type DeploymentFilterFlows struct {
+	ID *DeploymentFilterAnyID `json:"id,omitempty"`
+}
+
+// DeploymentFilterDeployments defines filter criteria searching on deployments.
+type DeploymentFilterDeployments struct {
+	Name *DeploymentFilterAnyName `json:"name,omitempty"`
+	Tags *DeploymentFilterAllTags `json:"tags,omitempty"`
+}
+
+// DeploymentFilterAnyID defines filter criteria matching any of the IDs.
+type DeploymentFilterAnyID struct {
+	Any []uuid.UUID `json:"any_"`
+}
+
+// DeploymentFilterAnyName defines filter criteria matching any of the names.
+type DeploymentFilterAnyName struct {
+	Any []string `json:"any_"`
+}
+
+// DeploymentFilterAllTags defines filter criteria matching all of the tags.
+type DeploymentFilterAllTags struct {
+	All []string `json:"all_"`
 }
 
 type DeploymentAccess struct {
@@ -0,0 +1,8 @@
+package api
+
+import "errors"
+
+// ErrNotFound is wrapped by the errors of clients when the API responds
+// that the requested object doesn't exist, e.g. because it was deleted
+// outside of Terraform.
+var ErrNotFound = errors.New("not found")
@@ -0,0 +1,44 @@
+package api
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// FlowRunNotificationPoliciesClient is a client for working with flow run notification policies.
+type FlowRunNotificationPoliciesClient interface {
+	Create(ctx context.Context, data FlowRunNotificationPolicyCreate) (*FlowRunNotificationPolicy, error)
+	Get(ctx context.Context, policyID uuid.UUID) (*FlowRunNotificationPolicy, error)
+	Update(ctx context.Context, policyID uuid.UUID, data FlowRunNotificationPolicyUpdate) error
+	Delete(ctx context.Context, policyID uuid.UUID) error
+}
+
+// FlowRunNotificationPolicy is a representation of a flow run notification policy.
+type FlowRunNotificationPolicy struct {
+	BaseModel
+	IsActive        bool      `json:"is_active"`
+	StateNames      []string  `json:"state_names"`
+	Tags            []string  `json:"tags"`
+	BlockDocumentID uuid.UUID `json:"block_document_id"`
+	MessageTemplate *string   `json:"message_template"`
+}
+
+// FlowRunNotificationPolicyCreate is a subset of FlowRunNotificationPolicy used when creating flow run notification policies.
+type FlowRunNotificationPolicyCreate struct {
+	IsActive        bool      `json:"is_active"`
+	StateNames      []string  `json:"state_names"`
+	Tags            []string  `json:"tags"`
+	BlockDocumentID uuid.UUID `json:"block_document_id"`
+	MessageTemplate *string   `json:"message_template,omitempty"`
+}
+
+// FlowRunNotificationPolicyUpdate is a subset of FlowRunNotificationPolicy used when updating flow run notification policies.
+// Only the fields that are set are changed.
+type FlowRunNotificationPolicyUpdate struct {
+	IsActive        *bool      `json:"is_active,omitempty"`
+	StateNames      *[]string  `json:"state_names,omitempty"`
+	Tags            *[]string  `json:"tags,omitempty"`
+	BlockDocumentID *uuid.UUID `json:"block_document_id,omitempty"`
+	MessageTemplate *string    `json:"message_template,omitempty"`
+}
@@ -2,14 +2,20 @@ package api
 
 import (
 	"context"
+	"errors"
 
 	"github.com/google/uuid"
 )
 
+// ErrFlowAlreadyExists is returned when creating a flow whose name
+// is already taken in the workspace.
+var ErrFlowAlreadyExists = errors.New("flow already exists")
+
 // FlowsClient is a client for working with flows.
 type FlowsClient interface {
 	Create(ctx context.Context, data FlowCreate) (*Flow, error)
 	Get(ctx context.Context, flowID uuid.UUID) (*Flow, error)
+	GetByName(ctx context.Context, name string) (*Flow, error)
 	List(ctx context.Context, handleNames []string) ([]*Flow, error)
 	Update(ctx context.Context, flowID uuid.UUID, data FlowUpdate) error
 	Delete(ctx context.Context, flowID uuid.UUID) error
@@ -18,32 +24,36 @@ type FlowsClient interface {
 // Flow is a representation of a flow.
 type Flow struct {
 	BaseModel
-	AccountID   uuid.UUID `json:"account_id"`
-	WorkspaceID uuid.UUID `json:"workspace_id"`
-	Name        string    `json:"name"`
-	Tags        []string  `json:"tags"`
+	AccountID   uuid.UUID         `json:"account_id"`
+	WorkspaceID uuid.UUID         `json:"workspace_id"`
+	Name        string            `json:"name"`
+	Tags        []string          `json:"tags"`
+	Labels      map[string]string `json:"labels"`
 }
 
 // FlowCreate is a subset of Flow used when creating flows.
 type FlowCreate struct {
-	Name string   `json:"name"`
-	Tags []string `json:"tags"`
+	Name   string            `json:"name"`
+	Tags   []string          `json:"tags"`
+	Labels map[string]string `json:"labels,omitempty"`
 }
 
 // FlowUpdate is a subset of Flow used when updating flows.
+// Flows can't be renamed, so only the tags and labels can be updated.
+// Fields are only sent when set, as older servers don't accept labels.
 type FlowUpdate struct {
-	Name *string  `json:"name"`
-	Tags []string `json:"tags"`
+	Tags   *[]string          `json:"tags,omitempty"`
+	Labels *map[string]string `json:"labels,omitempty"`
 }
 
 // FlowFilter defines the search filter payload
 // when searching for flows by name.
 // example request payload:
-// {"flows": {"handle": {"any_": ["test"]}}}.
+// {"flows": {"name": {"any_": ["test"]}}}.
 type FlowFilter struct {
 	Flows struct {
-		Handle struct {
+		Name struct {
 			Any []string `json:"any_"`
-		} `json:"handle"`
+		} `json:"name"`
 	} `json:"flows"`
 }
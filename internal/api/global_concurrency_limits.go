@@ -0,0 +1,41 @@
+package api
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// GlobalConcurrencyLimitsClient is a client for working with global concurrency limits.
+type GlobalConcurrencyLimitsClient interface {
+	Create(ctx context.Context, data GlobalConcurrencyLimitCreate) (*GlobalConcurrencyLimit, error)
+	Get(ctx context.Context, limitID uuid.UUID) (*GlobalConcurrencyLimit, error)
+	Update(ctx context.Context, limitID uuid.UUID, data GlobalConcurrencyLimitUpdate) error
+	Delete(ctx context.Context, limitID uuid.UUID) error
+}
+
+// GlobalConcurrencyLimit is a representation of a global concurrency limit.
+type GlobalConcurrencyLimit struct {
+	BaseModel
+	Name               string  `json:"name"`
+	Limit              int64   `json:"limit"`
+	Active             bool    `json:"active"`
+	ActiveSlots        int64   `json:"active_slots"`
+	SlotDecayPerSecond float64 `json:"slot_decay_per_second"`
+}
+
+// GlobalConcurrencyLimitCreate is a subset of GlobalConcurrencyLimit used when creating global concurrency limits.
+type GlobalConcurrencyLimitCreate struct {
+	Name               string  `json:"name"`
+	Limit              int64   `json:"limit"`
+	Active             bool    `json:"active"`
+	SlotDecayPerSecond float64 `json:"slot_decay_per_second"`
+}
+
+// GlobalConcurrencyLimitUpdate is a subset of GlobalConcurrencyLimit used when updating global concurrency limits.
+// Only the fields that are set are changed.
+type GlobalConcurrencyLimitUpdate struct {
+	Limit              *int64   `json:"limit,omitempty"`
+	Active             *bool    `json:"active,omitempty"`
+	SlotDecayPerSecond *float64 `json:"slot_decay_per_second,omitempty"`
+}
@@ -0,0 +1,30 @@
+package api
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// TagConcurrencyLimitsClient is a client for working with tag-based concurrency limits.
+type TagConcurrencyLimitsClient interface {
+	Upsert(ctx context.Context, data TagConcurrencyLimitUpsert) (*TagConcurrencyLimit, error)
+	Get(ctx context.Context, limitID uuid.UUID) (*TagConcurrencyLimit, error)
+	GetByTag(ctx context.Context, tag string) (*TagConcurrencyLimit, error)
+	Delete(ctx context.Context, limitID uuid.UUID) error
+}
+
+// TagConcurrencyLimit is a representation of a tag-based concurrency limit.
+type TagConcurrencyLimit struct {
+	BaseModel
+	Tag              string      `json:"tag"`
+	ConcurrencyLimit int64       `json:"concurrency_limit"`
+	ActiveSlots      []uuid.UUID `json:"active_slots"`
+}
+
+// TagConcurrencyLimitUpsert is a subset of TagConcurrencyLimit used when
+// creating or updating tag-based concurrency limits, which are keyed by tag.
+type TagConcurrencyLimitUpsert struct {
+	Tag              string `json:"tag"`
+	ConcurrencyLimit int64  `json:"concurrency_limit"`
+}
@@ -1,7 +1,9 @@
 package api
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 
 	"github.com/google/uuid"
 )
@@ -22,36 +24,77 @@ type Variable struct {
 	Name  string   `json:"name"`
 	Value string   `json:"value"`
 	Tags  []string `json:"tags"`
+
+	// RawValue is the compacted JSON encoding of the value, which, unlike
+	// Value, tells a JSON string or null apart from other values.
+	RawValue json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON decodes a variable, keeping values that aren't strings,
+// e.g. numbers or objects, as their JSON encoding.
+func (v *Variable) UnmarshalJSON(data []byte) error {
+	type variableAlias Variable
+	aux := struct {
+		*variableAlias
+		Value json.RawMessage `json:"value"`
+	}{variableAlias: (*variableAlias)(v)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	if len(aux.Value) == 0 {
+		aux.Value = json.RawMessage("null")
+	}
+
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, aux.Value); err != nil {
+		return err
+	}
+	v.RawValue = compacted.Bytes()
+
+	switch {
+	case bytes.Equal(v.RawValue, []byte("null")):
+		v.Value = ""
+	case v.RawValue[0] == '"':
+		return json.Unmarshal(v.RawValue, &v.Value)
+	default:
+		v.Value = compacted.String()
+	}
+
+	return nil
 }
 
 // VariableCreate is a subset of Variable used when creating variables.
+// The value is any JSON value, usually a string.
 type VariableCreate struct {
-	Name  string   `json:"name"`
-	Value string   `json:"value"`
-	Tags  []string `json:"tags"`
+	Name  string      `json:"name"`
+	Value interface{} `json:"value"`
+	Tags  []string    `json:"tags"`
 }
 
 // VariableUpdate is a subset of Variable used when updating variables.
+// The value is any JSON value, usually a string.
 type VariableUpdate struct {
-	Name  string   `json:"name"`
-	Value string   `json:"value"`
-	Tags  []string `json:"tags"`
+	Name  string      `json:"name"`
+	Value interface{} `json:"value"`
+	Tags  []string    `json:"tags"`
 }
 
 // VariableFilterSettings defines settings when searching for variables.
 type VariableFilterSettings struct {
-	Limit     *int64          `json:"limit"`
-	Offset    *int64          `json:"offset"`
-	Variables *VariableFilter `json:"variables"`
-	Sort      string          `json:"sort"`
+	Limit     *int64          `json:"limit,omitempty"`
+	Offset    *int64          `json:"offset,omitempty"`
+	Variables *VariableFilter `json:"variables,omitempty"`
+	Sort      string          `json:"sort,omitempty"`
 }
 
 // VariableFilter defines filters when searching for variables.
 type VariableFilter struct {
-	ID    *VariableFilterID    `json:"id"`
-	Name  *VariableFilterName  `json:"name"`
-	Value *VariableFilterValue `json:"value"`
-	Tags  *VariableFilterTags  `json:"tags"`
+	ID    *VariableFilterID    `json:"id,omitempty"`
+	Name  *VariableFilterName  `json:"name,omitempty"`
+	Value *VariableFilterValue `json:"value,omitempty"`
+	Tags  *VariableFilterTags  `json:"tags,omitempty"`
 }
 
 // VariableFilterID defines filter criteria searching on variable IDs.
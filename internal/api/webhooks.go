@@ -0,0 +1,42 @@
+package api
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// WebhooksClient is a client for working with webhooks.
+type WebhooksClient interface {
+	Create(ctx context.Context, data WebhookCreate) (*Webhook, error)
+	Get(ctx context.Context, webhookID uuid.UUID) (*Webhook, error)
+	Update(ctx context.Context, webhookID uuid.UUID, data WebhookUpdate) error
+	Delete(ctx context.Context, webhookID uuid.UUID) error
+	EndpointURL(slug string) string
+}
+
+// Webhook is a representation of a webhook.
+type Webhook struct {
+	BaseModel
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Template    string `json:"template"`
+	Enabled     bool   `json:"enabled"`
+	Slug        string `json:"slug"`
+}
+
+// WebhookCreate is a subset of Webhook used when creating webhooks.
+type WebhookCreate struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Template    string `json:"template"`
+	Enabled     bool   `json:"enabled"`
+}
+
+// WebhookUpdate is a subset of Webhook used when updating webhooks.
+type WebhookUpdate struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Template    string `json:"template"`
+	Enabled     bool   `json:"enabled"`
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -13,8 +14,13 @@ type WorkPoolsClient interface {
 	Get(ctx context.Context, name string) (*WorkPool, error)
 	Update(ctx context.Context, name string, data WorkPoolUpdate) error
 	Delete(ctx context.Context, name string) error
+	ListQueues(ctx context.Context, name string) ([]*WorkQueue, error)
+	ListWorkers(ctx context.Context, name string) ([]*Worker, error)
 }
 
+// WorkerStatusOnline is the status of a worker that is polling its work pool.
+const WorkerStatusOnline = "ONLINE"
+
 // WorkPool is a representation of a work pool.
 type WorkPool struct {
 	BaseModel
@@ -27,6 +33,25 @@ type WorkPool struct {
 	DefaultQueueID   uuid.UUID              `json:"default_queue_id"`
 }
 
+// WorkQueue is a representation of a work queue within a work pool.
+type WorkQueue struct {
+	BaseModel
+	Name             string  `json:"name"`
+	Description      *string `json:"description"`
+	IsPaused         bool    `json:"is_paused"`
+	ConcurrencyLimit *int64  `json:"concurrency_limit"`
+	Priority         int64   `json:"priority"`
+}
+
+// Worker is a representation of a worker polling a work pool.
+type Worker struct {
+	BaseModel
+	Name              string     `json:"name"`
+	WorkPoolID        uuid.UUID  `json:"work_pool_id"`
+	LastHeartbeatTime *time.Time `json:"last_heartbeat_time"`
+	Status            string     `json:"status"`
+}
+
 // WorkPoolCreate is a subset of WorkPool used when creating pools.
 type WorkPoolCreate struct {
 	Name             string                 `json:"name"`
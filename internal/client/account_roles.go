@@ -1,11 +1,9 @@
 package client
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -38,39 +36,10 @@ func (c *Client) AccountRoles(accountID uuid.UUID) (api.AccountRolesClient, erro
 
 // List returns a list of account roles, based on the provided filter.
 func (c *AccountRolesClient) List(ctx context.Context, roleNames []string) ([]*api.AccountRole, error) {
-	var buf bytes.Buffer
 	filterQuery := api.AccountRoleFilter{}
 	filterQuery.AccountRoles.Name.Any = roleNames
 
-	if err := json.NewEncoder(&buf).Encode(&filterQuery); err != nil {
-		return nil, fmt.Errorf("failed to encode filter payload data: %w", err)
-	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/filter", c.routePrefix), &buf)
-	if err != nil {
-		return nil, fmt.Errorf("error creating request: %w", err)
-	}
-
-	setDefaultHeaders(req, c.apiKey)
-
-	resp, err := c.hc.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("http error: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
-	}
-
-	var accountRoles []*api.AccountRole
-	if err := json.NewDecoder(resp.Body).Decode(&accountRoles); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	return accountRoles, nil
+	return listAll[*api.AccountRole](ctx, c.hc, c.apiKey, fmt.Sprintf("%s/filter", c.routePrefix), &filterQuery)
 }
 
 // Get returns an account role by ID.
@@ -88,9 +57,7 @@ func (c *AccountRolesClient) Get(ctx context.Context, roleID uuid.UUID) (*api.Ac
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return nil, errorFromResponse(resp)
 	}
 
 	var accountRole api.AccountRole
@@ -5,14 +5,16 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 )
 
-var _ = api.AccountsClient(&AccountsClient{})
+var (
+	_ = api.AccountsClient(&AccountsClient{})
+	_ = api.AccountCreationClient(&AccountCreationClient{})
+)
 
 // AccountsClient is a client for working with accounts.
 type AccountsClient struct {
@@ -21,6 +23,13 @@ type AccountsClient struct {
 	routePrefix string
 }
 
+// AccountCreationClient is a client for creating accounts.
+type AccountCreationClient struct {
+	hc          *http.Client
+	apiKey      string
+	routePrefix string
+}
+
 // Accounts returns an AccountsClient.
 //
 //nolint:ireturn // required to support PrefectClient mocking
@@ -40,6 +49,54 @@ func (c *Client) Accounts(accountID uuid.UUID) (api.AccountsClient, error) {
 	}, nil
 }
 
+// AccountCreation returns an AccountCreationClient. Accounts are created
+// outside of any account, so no account ID is needed.
+//
+//nolint:ireturn // required to support PrefectClient mocking
+func (c *Client) AccountCreation() (api.AccountCreationClient, error) {
+	return &AccountCreationClient{
+		hc:          c.hc,
+		apiKey:      c.apiKey,
+		routePrefix: joinURL(c.endpoint, "accounts", ""),
+	}, nil
+}
+
+// Create creates a new account.
+func (c *AccountCreationClient) Create(ctx context.Context, data api.AccountCreate) (*api.AccountResponse, error) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&data); err != nil {
+		return nil, fmt.Errorf("failed to encode data: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.routePrefix, &buf)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusConflict {
+		return nil, fmt.Errorf("%w: %q", api.ErrAccountHandleTaken, data.Handle)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, errorFromResponse(resp)
+	}
+
+	var account api.AccountResponse
+	if err := json.NewDecoder(resp.Body).Decode(&account); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &account, nil
+}
+
 // Get returns details for an account by ID.
 func (c *AccountsClient) Get(ctx context.Context) (*api.AccountResponse, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.routePrefix, http.NoBody)
@@ -56,9 +113,7 @@ func (c *AccountsClient) Get(ctx context.Context) (*api.AccountResponse, error)
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return nil, errorFromResponse(resp)
 	}
 
 	var account api.AccountResponse
@@ -90,9 +145,7 @@ func (c *AccountsClient) Update(ctx context.Context, data api.AccountUpdate) err
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return errorFromResponse(resp)
 	}
 
 	return nil
@@ -120,9 +173,7 @@ func (c *AccountsClient) UpdateSettings(ctx context.Context, data api.AccountSet
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return errorFromResponse(resp)
 	}
 
 	return nil
@@ -144,9 +195,7 @@ func (c *AccountsClient) Delete(ctx context.Context) error {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return errorFromResponse(resp)
 	}
 
 	return nil
@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+var _ = api.AdminClient(&AdminClient{})
+
+// AdminClient is a client for working with the server's administrative endpoints.
+type AdminClient struct {
+	hc            *http.Client
+	apiKey        string
+	routePrefix   string
+	serverVersion *serverVersion
+}
+
+// serverVersion caches the server's version, which doesn't change while
+// the provider runs, so that it's only requested once.
+type serverVersion struct {
+	once    sync.Once
+	version string
+	err     error
+}
+
+// Admin returns an AdminClient.
+//
+//nolint:ireturn // required to support PrefectClient mocking
+func (c *Client) Admin() (api.AdminClient, error) {
+	return &AdminClient{
+		hc:            c.hc,
+		apiKey:        c.apiKey,
+		routePrefix:   joinURL(c.endpoint, "admin"),
+		serverVersion: c.serverVersion,
+	}, nil
+}
+
+// Version returns the version of the Prefect server. Servers that don't
+// expose their version, such as Prefect Cloud, return an error.
+func (c *AdminClient) Version(ctx context.Context) (string, error) {
+	if c.serverVersion == nil {
+		return c.getVersion(ctx)
+	}
+
+	c.serverVersion.once.Do(func() {
+		c.serverVersion.version, c.serverVersion.err = c.getVersion(ctx)
+	})
+
+	return c.serverVersion.version, c.serverVersion.err
+}
+
+func (c *AdminClient) getVersion(ctx context.Context) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.routePrefix+"/version", http.NoBody)
+	if err != nil {
+		return "", fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", errorFromResponse(resp)
+	}
+
+	var version string
+	if err := json.NewDecoder(resp.Body).Decode(&version); err != nil {
+		return "", fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return version, nil
+}
@@ -0,0 +1,50 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// apiKeyFileTransport is an http.RoundTripper that authenticates each
+// request with the API key currently written to a file, so a key that's
+// rotated mid-apply, e.g. a short-lived OIDC token, is picked up without
+// restarting the provider.
+//
+// The file is read for every request, and its content replaces the
+// Authorization header set from the static API key.
+type apiKeyFileTransport struct {
+	next http.RoundTripper
+	path string
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *apiKeyFileTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	apiKey, err := readAPIKeyFile(t.path)
+	if err != nil {
+		return nil, err
+	}
+
+	// A RoundTripper must not modify the request it's given.
+	req = req.Clone(req.Context())
+	setAuthorizationHeader(req, apiKey)
+
+	return t.next.RoundTrip(req)
+}
+
+// readAPIKeyFile returns the API key written to a file, without the
+// surrounding whitespace, e.g. a trailing newline.
+func readAPIKeyFile(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the API key from api_key_file: %w", err)
+	}
+
+	apiKey := strings.TrimSpace(string(content))
+	if apiKey == "" {
+		return "", fmt.Errorf("failed to read the API key from api_key_file: %q is empty", path)
+	}
+
+	return apiKey, nil
+}
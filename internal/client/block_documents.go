@@ -5,7 +5,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"strings"
 
@@ -63,9 +62,7 @@ func (c *BlockDocumentClient) Get(ctx context.Context, id uuid.UUID) (*api.Block
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return nil, errorFromResponse(resp)
 	}
 
 	var blockDocument api.BlockDocument
@@ -96,9 +93,7 @@ func (c *BlockDocumentClient) GetByName(ctx context.Context, typeSlug, name stri
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return nil, errorFromResponse(resp)
 	}
 
 	var blockDocument api.BlockDocument
@@ -109,6 +104,25 @@ func (c *BlockDocumentClient) GetByName(ctx context.Context, typeSlug, name stri
 	return &blockDocument, nil
 }
 
+// List returns the named block documents, optionally only those of the given
+// block types. Secret values in the block data are masked by the API.
+func (c *BlockDocumentClient) List(ctx context.Context, typeSlugs []string) ([]*api.BlockDocument, error) {
+	filter := api.BlockDocumentFilterSettings{
+		BlockDocuments: &api.BlockDocumentFilter{
+			IsAnonymous: &api.BlockDocumentFilterIsAnonymous{Eq: false},
+		},
+		IncludeSecrets: false,
+	}
+
+	if len(typeSlugs) > 0 {
+		filter.BlockTypes = &api.BlockDocumentFilterBlockType{
+			Slug: &api.BlockDocumentFilterBlockTypeSlug{Any: typeSlugs},
+		}
+	}
+
+	return listAll[*api.BlockDocument](ctx, c.hc, c.apiKey, fmt.Sprintf("%s/filter", c.routePrefix), &filter)
+}
+
 func (c *BlockDocumentClient) Create(ctx context.Context, payload api.BlockDocumentCreate) (*api.BlockDocument, error) {
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(&payload); err != nil {
@@ -129,9 +143,7 @@ func (c *BlockDocumentClient) Create(ctx context.Context, payload api.BlockDocum
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return nil, errorFromResponse(resp)
 	}
 
 	var blockDocument api.BlockDocument
@@ -162,9 +174,7 @@ func (c *BlockDocumentClient) Update(ctx context.Context, id uuid.UUID, payload
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return errorFromResponse(resp)
 	}
 
 	return nil
@@ -185,9 +195,7 @@ func (c *BlockDocumentClient) Delete(ctx context.Context, id uuid.UUID) error {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return errorFromResponse(resp)
 	}
 
 	return nil
@@ -208,9 +216,7 @@ func (c *BlockDocumentClient) GetAccess(ctx context.Context, id uuid.UUID) (*api
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return nil, errorFromResponse(resp)
 	}
 
 	var blockDocumentAccess api.BlockDocumentAccess
@@ -241,9 +247,7 @@ func (c *BlockDocumentClient) UpsertAccess(ctx context.Context, id uuid.UUID, pa
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return errorFromResponse(resp)
 	}
 
 	return nil
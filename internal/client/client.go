@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -17,7 +18,9 @@ var _ = api.PrefectClient(&Client{})
 // New creates and returns new client instance.
 func New(opts ...Option) (*Client, error) {
 	client := &Client{
-		hc: http.DefaultClient,
+		hc:             http.DefaultClient,
+		requestTimeout: DefaultRequestTimeout,
+		serverVersion:  &serverVersion{},
 	}
 
 	var errs []error
@@ -33,6 +36,52 @@ func New(opts ...Option) (*Client, error) {
 		return nil, errors.Join(errs...)
 	}
 
+	transport := client.hc.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	if len(client.httpHeaders) > 0 {
+		transport = &headersTransport{
+			next:    transport,
+			headers: client.httpHeaders,
+		}
+	}
+
+	// The API key is read from the file right before each request is sent,
+	// after any wait on the rate limit, so it's as recent as can be.
+	if client.apiKeyFile != "" {
+		transport = &apiKeyFileTransport{
+			next: transport,
+			path: client.apiKeyFile,
+		}
+	}
+
+	// The timeout applies to each attempt, so it sits underneath the
+	// rate limit, and time spent waiting on the rate limit isn't counted
+	// against it.
+	if client.requestTimeout > 0 {
+		transport = &timeoutTransport{
+			next:    transport,
+			timeout: client.requestTimeout,
+		}
+	}
+
+	// The limiter wraps the transport of the configured http.Client, so it's
+	// shared by every sub-client, regardless of the order of the options.
+	if client.rateLimit > 0 {
+		transport = &rateLimitedTransport{
+			next:    transport,
+			limiter: newRateLimiter(client.rateLimit),
+		}
+	}
+
+	if len(client.httpHeaders) > 0 || client.apiKeyFile != "" || client.requestTimeout > 0 || client.rateLimit > 0 {
+		hc := *client.hc
+		hc.Transport = transport
+		client.hc = &hc
+	}
+
 	return client, nil
 }
 
@@ -65,11 +114,9 @@ func WithEndpoint(endpoint string) Option {
 			return fmt.Errorf("endpoint is not a valid url: %w", err)
 		}
 
-		if strings.HasSuffix(endpoint, "/") {
-			return fmt.Errorf("endpoint %q must not include trailing slash", endpoint)
-		}
-
-		client.endpoint = endpoint
+		// Routes are joined to the endpoint, so a trailing slash is
+		// dropped rather than doubled.
+		client.endpoint = strings.TrimRight(endpoint, "/")
 
 		return nil
 	}
@@ -84,6 +131,32 @@ func WithAPIKey(apiKey string) Option {
 	}
 }
 
+// WithAPIKeyFile configures a file to read the API Key from before each
+// request, instead of the static API Key, so a rotated key is picked up.
+func WithAPIKeyFile(path string) Option {
+	return func(client *Client) error {
+		client.apiKeyFile = path
+
+		return nil
+	}
+}
+
+// WithHTTPHeaders configures custom headers added to each request. They
+// can't set the Authorization header, which is reserved for the API Key.
+func WithHTTPHeaders(headers map[string]string) Option {
+	return func(client *Client) error {
+		for key := range headers {
+			if http.CanonicalHeaderKey(key) == "Authorization" {
+				return fmt.Errorf("header %q must not be set: use the API key to authenticate instead", key)
+			}
+		}
+
+		client.httpHeaders = headers
+
+		return nil
+	}
+}
+
 // WithDefaults configures the default account and workspace ID.
 func WithDefaults(accountID uuid.UUID, workspaceID uuid.UUID) Option {
 	return func(client *Client) error {
@@ -97,3 +170,31 @@ func WithDefaults(accountID uuid.UUID, workspaceID uuid.UUID) Option {
 		return nil
 	}
 }
+
+// WithRateLimit configures the maximum number of requests per second sent
+// to the API, across all resources. A zero value disables rate limiting.
+func WithRateLimit(requestsPerSecond float64) Option {
+	return func(client *Client) error {
+		if requestsPerSecond < 0 {
+			return fmt.Errorf("rate limit must be positive: rate limit is %v", requestsPerSecond)
+		}
+
+		client.rateLimit = requestsPerSecond
+
+		return nil
+	}
+}
+
+// WithRequestTimeout configures how long each request to the API may take,
+// including reading the response. A zero value disables the timeout.
+func WithRequestTimeout(timeout time.Duration) Option {
+	return func(client *Client) error {
+		if timeout < 0 {
+			return fmt.Errorf("request timeout must not be negative: request timeout is %s", timeout)
+		}
+
+		client.requestTimeout = timeout
+
+		return nil
+	}
+}
@@ -0,0 +1,108 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+)
+
+func TestHTTPHeadersHelper(t *testing.T) {
+	t.Parallel()
+
+	accountID := uuid.New()
+
+	var tenant, authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tenant = r.Header.Get("X-Tenant")
+		authorization = r.Header.Get("Authorization")
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "` + accountID.String() + `", "name": "account", "handle": "account"}`))
+	}))
+	defer server.Close()
+
+	prefectClient := client.MustNew(
+		client.WithEndpoint(server.URL),
+		client.WithAPIKey("api-key"),
+		client.WithHTTPHeaders(map[string]string{"X-Tenant": "data"}),
+	)
+
+	accountsClient, err := prefectClient.Accounts(accountID)
+	if err != nil {
+		t.Fatalf("error creating accounts client: %s", err)
+	}
+
+	if _, err := accountsClient.Get(context.Background()); err != nil {
+		t.Fatalf("error getting account: %s", err)
+	}
+
+	if tenant != "data" {
+		t.Fatalf("X-Tenant header should be %q, but got %q", "data", tenant)
+	}
+	if authorization != "Bearer api-key" {
+		t.Fatalf("Authorization header should be set from the API key, but got %q", authorization)
+	}
+
+	// The Authorization header is reserved for the API key.
+	if _, err := client.New(client.WithHTTPHeaders(map[string]string{"authorization": "Bearer other-key"})); err == nil {
+		t.Fatalf("an Authorization header should be rejected")
+	}
+}
+
+func TestEndpointURLHelper(t *testing.T) {
+	t.Parallel()
+
+	accountID := uuid.New()
+	variableID := uuid.New()
+
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		if strings.Contains(r.URL.Path, "/variables/") {
+			_, _ = w.Write([]byte(`{"id": "` + variableID.String() + `", "name": "variable", "value": "value", "tags": []}`))
+
+			return
+		}
+		_, _ = w.Write([]byte(`{"id": "` + accountID.String() + `", "name": "account", "handle": "account"}`))
+	}))
+	defer server.Close()
+
+	// A self-hosted server behind a reverse proxy, serving the API under a prefix.
+	for _, endpoint := range []string{server.URL + "/prefect/api", server.URL + "/prefect/api/"} {
+		paths = nil
+		prefectClient := client.MustNew(client.WithEndpoint(endpoint))
+
+		accountsClient, err := prefectClient.Accounts(accountID)
+		if err != nil {
+			t.Fatalf("error creating accounts client: %s", err)
+		}
+		if _, err := accountsClient.Get(context.Background()); err != nil {
+			t.Fatalf("error getting account with endpoint %q: %s", endpoint, err)
+		}
+
+		variablesClient, err := prefectClient.Variables(uuid.Nil, uuid.Nil)
+		if err != nil {
+			t.Fatalf("error creating variables client: %s", err)
+		}
+		if _, err := variablesClient.Get(context.Background(), variableID); err != nil {
+			t.Fatalf("error getting variable with endpoint %q: %s", endpoint, err)
+		}
+
+		want := []string{
+			"/prefect/api/accounts/" + accountID.String() + "/",
+			"/prefect/api/variables/" + variableID.String(),
+		}
+		if fmt.Sprint(paths) != fmt.Sprint(want) {
+			t.Fatalf("endpoint %q should request %v, but requested %v", endpoint, want, paths)
+		}
+	}
+}
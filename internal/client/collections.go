@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
@@ -25,7 +24,7 @@ func (c *Client) Collections() (api.CollectionsClient, error) {
 	return &CollectionsClient{
 		hc:          c.hc,
 		apiKey:      c.apiKey,
-		routePrefix: fmt.Sprintf("%s/collections", c.endpoint),
+		routePrefix: joinURL(c.endpoint, "collections"),
 	}, nil
 }
 
@@ -45,9 +44,7 @@ func (c *CollectionsClient) GetWorkerMetadataViews(ctx context.Context) (api.Wor
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return nil, errorFromResponse(resp)
 	}
 
 	var workerTypeByPackage api.WorkerTypeByPackage
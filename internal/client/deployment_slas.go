@@ -0,0 +1,155 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+var _ = api.DeploymentSLAsClient(&DeploymentSLAsClient{})
+
+// DeploymentSLAsClient is a client for working with the SLAs of a deployment.
+type DeploymentSLAsClient struct {
+	hc          *http.Client
+	apiKey      string
+	routePrefix string
+}
+
+// DeploymentSLAs returns a DeploymentSLAsClient.
+//
+//nolint:ireturn // required to support PrefectClient mocking
+func (c *Client) DeploymentSLAs(accountID uuid.UUID, workspaceID uuid.UUID) (api.DeploymentSLAsClient, error) {
+	if accountID == uuid.Nil {
+		accountID = c.defaultAccountID
+	}
+	if workspaceID == uuid.Nil {
+		workspaceID = c.defaultWorkspaceID
+	}
+
+	return &DeploymentSLAsClient{
+		hc:          c.hc,
+		apiKey:      c.apiKey,
+		routePrefix: getWorkspaceScopedURL(c.endpoint, accountID, workspaceID, "deployments"),
+	}, nil
+}
+
+// slasURL returns the URL of the SLAs of a deployment.
+func (c *DeploymentSLAsClient) slasURL(deploymentID uuid.UUID) string {
+	return fmt.Sprintf("%s/%s/slas", c.routePrefix, deploymentID.String())
+}
+
+// List returns the SLAs of a deployment.
+func (c *DeploymentSLAsClient) List(ctx context.Context, deploymentID uuid.UUID) ([]*api.DeploymentSLA, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.slasURL(deploymentID), http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errorFromResponse(resp)
+	}
+
+	var slas []*api.DeploymentSLA
+	if err := json.NewDecoder(resp.Body).Decode(&slas); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return slas, nil
+}
+
+// Create adds an SLA to a deployment.
+func (c *DeploymentSLAsClient) Create(ctx context.Context, deploymentID uuid.UUID, data api.DeploymentSLAUpsert) (*api.DeploymentSLA, error) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&data); err != nil {
+		return nil, fmt.Errorf("failed to encode data: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.slasURL(deploymentID), &buf)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, errorFromResponse(resp)
+	}
+
+	var sla api.DeploymentSLA
+	if err := json.NewDecoder(resp.Body).Decode(&sla); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &sla, nil
+}
+
+// Update modifies an SLA of a deployment by ID.
+func (c *DeploymentSLAsClient) Update(ctx context.Context, deploymentID uuid.UUID, slaID uuid.UUID, data api.DeploymentSLAUpsert) error {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&data); err != nil {
+		return fmt.Errorf("failed to encode data: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, c.slasURL(deploymentID)+"/"+slaID.String(), &buf)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return errorFromResponse(resp)
+	}
+
+	return nil
+}
+
+// Delete removes an SLA from a deployment by ID. An SLA that no longer
+// exists is treated as already deleted.
+func (c *DeploymentSLAsClient) Delete(ctx context.Context, deploymentID uuid.UUID, slaID uuid.UUID) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.slasURL(deploymentID)+"/"+slaID.String(), http.NoBody)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		return errorFromResponse(resp)
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -62,9 +61,7 @@ func (c *DeploymentsClient) Create(ctx context.Context, data api.DeploymentCreat
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return nil, errorFromResponse(resp)
 	}
 
 	var deployment api.Deployment
@@ -75,33 +72,9 @@ func (c *DeploymentsClient) Create(ctx context.Context, data api.DeploymentCreat
 	return &deployment, nil
 }
 
-// List returns a list of Deployments based on the provided list of names.
-func (c *DeploymentsClient) List(ctx context.Context, _ []string) ([]*api.Deployment, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/filter", c.routePrefix), http.NoBody)
-	if err != nil {
-		return nil, fmt.Errorf("error creating request: %w", err)
-	}
-
-	setDefaultHeaders(req, c.apiKey)
-
-	resp, err := c.hc.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("http error: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
-	}
-
-	var deployments []*api.Deployment
-	if err := json.NewDecoder(resp.Body).Decode(&deployments); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	return deployments, nil
+// List returns a list of Deployments, based on the provided filter.
+func (c *DeploymentsClient) List(ctx context.Context, filter api.DeploymentFilter) ([]*api.Deployment, error) {
+	return listAll[*api.Deployment](ctx, c.hc, c.apiKey, fmt.Sprintf("%s/filter", c.routePrefix), &filter)
 }
 
 // Get returns details for a Deployment by ID.
@@ -120,9 +93,7 @@ func (c *DeploymentsClient) Get(ctx context.Context, deploymentID uuid.UUID) (*a
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return nil, errorFromResponse(resp)
 	}
 
 	var deployment api.Deployment
@@ -154,9 +125,35 @@ func (c *DeploymentsClient) Update(ctx context.Context, id uuid.UUID, data api.D
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		errorBody, _ := io.ReadAll(resp.Body)
+		return errorFromResponse(resp)
+	}
+
+	return nil
+}
+
+// SetPaused pauses or resumes a Deployment by ID, using the dedicated
+// endpoints rather than a full update.
+func (c *DeploymentsClient) SetPaused(ctx context.Context, deploymentID uuid.UUID, paused bool) error {
+	action := "resume_deployment"
+	if paused {
+		action = "pause_deployment"
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/%s/%s", c.routePrefix, deploymentID.String(), action), http.NoBody)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
 
-		return fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return errorFromResponse(resp)
 	}
 
 	return nil
@@ -178,9 +175,7 @@ func (c *DeploymentsClient) Delete(ctx context.Context, deploymentID uuid.UUID)
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return errorFromResponse(resp)
 	}
 
 	return nil
@@ -0,0 +1,103 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+// errorFromResponse returns the error for an unexpected response status,
+// including the reason given by the API, e.g. the validation errors of a
+// 422 response, rather than only its status. A 404 response wraps
+// api.ErrNotFound.
+func errorFromResponse(resp *http.Response) error {
+	body, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: status code %s, error=%s", api.ErrNotFound, resp.Status, errorDetail(body))
+	}
+
+	return fmt.Errorf("status code %s, error=%s", resp.Status, errorDetail(body))
+}
+
+// errorResponseBody is the body of an error response from the API.
+//
+// `detail` is either a message or a list of validation errors, while
+// `exception_detail` is a list of validation errors, sent along with an
+// `exception_message` and the `request_body`. The request body is left
+// out, as it can contain secrets, such as block data.
+type errorResponseBody struct {
+	Detail           json.RawMessage `json:"detail"`
+	ExceptionMessage string          `json:"exception_message"`
+	ExceptionDetail  json.RawMessage `json:"exception_detail"`
+}
+
+// validationError is a single validation error of an error response.
+type validationError struct {
+	Loc []interface{} `json:"loc"`
+	Msg string        `json:"msg"`
+}
+
+// errorDetail returns the reason given in an error response body, or the
+// full body if it doesn't contain one.
+func errorDetail(body []byte) string {
+	var parsed errorResponseBody
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return string(body)
+	}
+
+	var messages []string
+	if parsed.ExceptionMessage != "" {
+		messages = append(messages, strings.TrimSuffix(parsed.ExceptionMessage, "."))
+	}
+	for _, detail := range []json.RawMessage{parsed.Detail, parsed.ExceptionDetail} {
+		if message := formatErrorDetail(detail); message != "" {
+			messages = append(messages, message)
+		}
+	}
+
+	if len(messages) == 0 {
+		return string(body)
+	}
+
+	return strings.Join(messages, ": ")
+}
+
+// formatErrorDetail formats a `detail` or `exception_detail` field, listing
+// each validation error along with the location of the invalid field.
+func formatErrorDetail(detail json.RawMessage) string {
+	if len(detail) == 0 {
+		return ""
+	}
+
+	var message string
+	if err := json.Unmarshal(detail, &message); err == nil {
+		return message
+	}
+
+	var validationErrors []validationError
+	if err := json.Unmarshal(detail, &validationErrors); err != nil || len(validationErrors) == 0 {
+		return string(detail)
+	}
+
+	messages := make([]string, 0, len(validationErrors))
+	for _, validationError := range validationErrors {
+		location := make([]string, 0, len(validationError.Loc))
+		for _, part := range validationError.Loc {
+			location = append(location, fmt.Sprint(part))
+		}
+
+		if len(location) == 0 {
+			messages = append(messages, validationError.Msg)
+
+			continue
+		}
+		messages = append(messages, fmt.Sprintf("%s: %s", strings.Join(location, "."), validationError.Msg))
+	}
+
+	return strings.Join(messages, "; ")
+}
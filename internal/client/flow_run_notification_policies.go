@@ -0,0 +1,150 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+var _ = api.FlowRunNotificationPoliciesClient(&FlowRunNotificationPoliciesClient{})
+
+// FlowRunNotificationPoliciesClient is a client for working with flow run notification policies.
+type FlowRunNotificationPoliciesClient struct {
+	hc          *http.Client
+	apiKey      string
+	routePrefix string
+}
+
+// FlowRunNotificationPolicys returns a FlowRunNotificationPoliciesClient.
+//
+//nolint:ireturn // required to support PrefectClient mocking
+func (c *Client) FlowRunNotificationPolicies(accountID uuid.UUID, workspaceID uuid.UUID) (api.FlowRunNotificationPoliciesClient, error) {
+	if accountID == uuid.Nil {
+		accountID = c.defaultAccountID
+	}
+	if workspaceID == uuid.Nil {
+		workspaceID = c.defaultWorkspaceID
+	}
+
+	return &FlowRunNotificationPoliciesClient{
+		hc:          c.hc,
+		apiKey:      c.apiKey,
+		routePrefix: getWorkspaceScopedURL(c.endpoint, accountID, workspaceID, "flow_run_notification_policies"),
+	}, nil
+}
+
+// Create returns details for a new flow run notification policy.
+func (c *FlowRunNotificationPoliciesClient) Create(ctx context.Context, data api.FlowRunNotificationPolicyCreate) (*api.FlowRunNotificationPolicy, error) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&data); err != nil {
+		return nil, fmt.Errorf("failed to encode data: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.routePrefix+"/", &buf)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, errorFromResponse(resp)
+	}
+
+	var policy api.FlowRunNotificationPolicy
+	if err := json.NewDecoder(resp.Body).Decode(&policy); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &policy, nil
+}
+
+// Get returns details for a flow run notification policy by ID.
+func (c *FlowRunNotificationPoliciesClient) Get(ctx context.Context, policyID uuid.UUID) (*api.FlowRunNotificationPolicy, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.routePrefix+"/"+policyID.String(), http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errorFromResponse(resp)
+	}
+
+	var policy api.FlowRunNotificationPolicy
+	if err := json.NewDecoder(resp.Body).Decode(&policy); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &policy, nil
+}
+
+// Update modifies an existing flow run notification policy by ID.
+func (c *FlowRunNotificationPoliciesClient) Update(ctx context.Context, policyID uuid.UUID, data api.FlowRunNotificationPolicyUpdate) error {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&data); err != nil {
+		return fmt.Errorf("failed to encode data: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, c.routePrefix+"/"+policyID.String(), &buf)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return errorFromResponse(resp)
+	}
+
+	return nil
+}
+
+// Delete removes a flow run notification policy by ID. A policy that no longer
+// exists is treated as already deleted.
+func (c *FlowRunNotificationPoliciesClient) Delete(ctx context.Context, policyID uuid.UUID) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.routePrefix+"/"+policyID.String(), http.NoBody)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		return errorFromResponse(resp)
+	}
+}
@@ -5,8 +5,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
+	"strings"
 
 	"github.com/google/uuid"
 
@@ -61,10 +61,19 @@ func (c *FlowsClient) Create(ctx context.Context, data api.FlowCreate) (*api.Flo
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusCreated {
-		errorBody, _ := io.ReadAll(resp.Body)
+	// The API returns the existing flow with a 200 (rather than a 201)
+	// when a flow with the same name already exists in the workspace.
+	if resp.StatusCode == http.StatusOK {
+		var existing api.Flow
+		if err := json.NewDecoder(resp.Body).Decode(&existing); err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
 
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return nil, fmt.Errorf("%w: %q has ID %s", api.ErrFlowAlreadyExists, existing.Name, existing.ID)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, errorFromResponse(resp)
 	}
 
 	var flow api.Flow
@@ -77,15 +86,15 @@ func (c *FlowsClient) Create(ctx context.Context, data api.FlowCreate) (*api.Flo
 
 // List returns a list of Flows, based on the provided list of handle names.
 func (c *FlowsClient) List(ctx context.Context, handleNames []string) ([]*api.Flow, error) {
-	var buf bytes.Buffer
 	filterQuery := api.WorkspaceFilter{}
 	filterQuery.Workspaces.Handle.Any = handleNames
 
-	if err := json.NewEncoder(&buf).Encode(&filterQuery); err != nil {
-		return nil, fmt.Errorf("failed to encode filter payload data: %w", err)
-	}
+	return listAll[*api.Flow](ctx, c.hc, c.apiKey, fmt.Sprintf("%s/filter", c.routePrefix), &filterQuery)
+}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/filter", c.routePrefix), &buf)
+// Get returns details for a Flow by ID.
+func (c *FlowsClient) Get(ctx context.Context, flowID uuid.UUID) (*api.Flow, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.routePrefix+"/"+flowID.String(), http.NoBody)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
 	}
@@ -99,22 +108,28 @@ func (c *FlowsClient) List(ctx context.Context, handleNames []string) ([]*api.Fl
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return nil, errorFromResponse(resp)
 	}
 
-	var flows []*api.Flow
-	if err := json.NewDecoder(resp.Body).Decode(&flows); err != nil {
+	var flow api.Flow
+	if err := json.NewDecoder(resp.Body).Decode(&flow); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	return flows, nil
+	return &flow, nil
 }
 
-// Get returns details for a Flow by ID.
-func (c *FlowsClient) Get(ctx context.Context, flowID uuid.UUID) (*api.Flow, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.routePrefix+"/"+flowID.String(), http.NoBody)
+// GetByName returns details for a Flow by name.
+func (c *FlowsClient) GetByName(ctx context.Context, name string) (*api.Flow, error) {
+	var buf bytes.Buffer
+	filterQuery := api.FlowFilter{}
+	filterQuery.Flows.Name.Any = []string{name}
+
+	if err := json.NewEncoder(&buf).Encode(&filterQuery); err != nil {
+		return nil, fmt.Errorf("failed to encode filter payload data: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/filter", c.routePrefix), &buf)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
 	}
@@ -128,17 +143,27 @@ func (c *FlowsClient) Get(ctx context.Context, flowID uuid.UUID) (*api.Flow, err
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return nil, errorFromResponse(resp)
 	}
 
-	var flow api.Flow
-	if err := json.NewDecoder(resp.Body).Decode(&flow); err != nil {
+	var flows []*api.Flow
+	if err := json.NewDecoder(resp.Body).Decode(&flows); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	return &flow, nil
+	switch len(flows) {
+	case 0:
+		return nil, fmt.Errorf("no flow found with name %q", name)
+	case 1:
+		return flows[0], nil
+	default:
+		matches := make([]string, 0, len(flows))
+		for _, flow := range flows {
+			matches = append(matches, fmt.Sprintf("%s (%s)", flow.Name, flow.ID))
+		}
+
+		return nil, fmt.Errorf("found %d flows matching name %q, expected 1: %s", len(flows), name, strings.Join(matches, ", "))
+	}
 }
 
 // Update modifies an existing Flow by ID.
@@ -163,9 +188,7 @@ func (c *FlowsClient) Update(ctx context.Context, flowID uuid.UUID, data api.Flo
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return errorFromResponse(resp)
 	}
 
 	return nil
@@ -187,9 +210,7 @@ func (c *FlowsClient) Delete(ctx context.Context, flowID uuid.UUID) error {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return errorFromResponse(resp)
 	}
 
 	return nil
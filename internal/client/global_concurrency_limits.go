@@ -0,0 +1,152 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+var _ = api.GlobalConcurrencyLimitsClient(&GlobalConcurrencyLimitsClient{})
+
+// GlobalConcurrencyLimitsClient is a client for working with global concurrency limits.
+type GlobalConcurrencyLimitsClient struct {
+	hc          *http.Client
+	apiKey      string
+	routePrefix string
+}
+
+// GlobalConcurrencyLimits returns a GlobalConcurrencyLimitsClient.
+//
+//nolint:ireturn // required to support PrefectClient mocking
+func (c *Client) GlobalConcurrencyLimits(accountID uuid.UUID, workspaceID uuid.UUID) (api.GlobalConcurrencyLimitsClient, error) {
+	if accountID == uuid.Nil {
+		accountID = c.defaultAccountID
+	}
+	if workspaceID == uuid.Nil {
+		workspaceID = c.defaultWorkspaceID
+	}
+
+	return &GlobalConcurrencyLimitsClient{
+		hc:     c.hc,
+		apiKey: c.apiKey,
+		// Global concurrency limits live under /v2, as /concurrency_limits
+		// is used by the tag-based concurrency limits.
+		routePrefix: getWorkspaceScopedURL(c.endpoint, accountID, workspaceID, "v2/concurrency_limits"),
+	}, nil
+}
+
+// Create returns details for a new global concurrency limit.
+func (c *GlobalConcurrencyLimitsClient) Create(ctx context.Context, data api.GlobalConcurrencyLimitCreate) (*api.GlobalConcurrencyLimit, error) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&data); err != nil {
+		return nil, fmt.Errorf("failed to encode data: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.routePrefix+"/", &buf)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, errorFromResponse(resp)
+	}
+
+	var limit api.GlobalConcurrencyLimit
+	if err := json.NewDecoder(resp.Body).Decode(&limit); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &limit, nil
+}
+
+// Get returns details for a global concurrency limit by ID.
+func (c *GlobalConcurrencyLimitsClient) Get(ctx context.Context, limitID uuid.UUID) (*api.GlobalConcurrencyLimit, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.routePrefix+"/"+limitID.String(), http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errorFromResponse(resp)
+	}
+
+	var limit api.GlobalConcurrencyLimit
+	if err := json.NewDecoder(resp.Body).Decode(&limit); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &limit, nil
+}
+
+// Update modifies an existing global concurrency limit by ID.
+func (c *GlobalConcurrencyLimitsClient) Update(ctx context.Context, limitID uuid.UUID, data api.GlobalConcurrencyLimitUpdate) error {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&data); err != nil {
+		return fmt.Errorf("failed to encode data: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, c.routePrefix+"/"+limitID.String(), &buf)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return errorFromResponse(resp)
+	}
+
+	return nil
+}
+
+// Delete removes a global concurrency limit by ID. A limit that no longer
+// exists is treated as already deleted.
+func (c *GlobalConcurrencyLimitsClient) Delete(ctx context.Context, limitID uuid.UUID) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.routePrefix+"/"+limitID.String(), http.NoBody)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		return errorFromResponse(resp)
+	}
+}
@@ -0,0 +1,29 @@
+package client
+
+import (
+	"net/http"
+)
+
+// headersTransport is an http.RoundTripper that adds custom headers to
+// each request, e.g. a header required by a gateway in front of the API.
+//
+// The Authorization header is left to the API key, so it's never set here.
+type headersTransport struct {
+	next    http.RoundTripper
+	headers map[string]string
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *headersTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the request it's given.
+	req = req.Clone(req.Context())
+	for key, value := range t.headers {
+		if http.CanonicalHeaderKey(key) == "Authorization" {
+			continue
+		}
+
+		req.Header.Set(key, value)
+	}
+
+	return t.next.RoundTrip(req)
+}
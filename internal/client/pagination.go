@@ -0,0 +1,82 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// filterPageSize is the number of results requested per page from filter
+// endpoints, which matches the default page size limit of the API.
+const filterPageSize = 200
+
+// listAll sends a filter payload to a filter endpoint one page at a time,
+// using `limit` and `offset`, until a page returns fewer than `limit`
+// results, and returns the results of every page.
+//
+// The filter can be any payload encoding to a JSON object, or nil to
+// list everything.
+func listAll[T any](ctx context.Context, hc *http.Client, apiKey, url string, filter interface{}) ([]T, error) {
+	payload := map[string]interface{}{}
+	if filter != nil {
+		encoded, err := json.Marshal(filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode filter payload data: %w", err)
+		}
+
+		if err := json.Unmarshal(encoded, &payload); err != nil {
+			return nil, fmt.Errorf("failed to encode filter payload data: %w", err)
+		}
+	}
+
+	results := make([]T, 0)
+	for offset := 0; ; offset += filterPageSize {
+		payload["limit"] = filterPageSize
+		payload["offset"] = offset
+
+		page, err := listPage[T](ctx, hc, apiKey, url, payload)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, page...)
+
+		if len(page) < filterPageSize {
+			return results, nil
+		}
+	}
+}
+
+// listPage sends a single page request to a filter endpoint.
+func listPage[T any](ctx context.Context, hc *http.Client, apiKey, url string, payload map[string]interface{}) ([]T, error) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&payload); err != nil {
+		return nil, fmt.Errorf("failed to encode filter payload data: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, &buf)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, apiKey)
+
+	resp, err := hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errorFromResponse(resp)
+	}
+
+	var page []T
+	if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return page, nil
+}
@@ -0,0 +1,175 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// rateLimitMaxRetries is the number of times a request is retried
+	// after the API responds with 429 Too Many Requests.
+	rateLimitMaxRetries = 3
+
+	// rateLimitMinFraction is the lowest fraction of the configured rate
+	// the limiter backs off to after repeated 429 responses.
+	rateLimitMinFraction = 0.1
+
+	// rateLimitRecoveryFactor is how much the rate grows back towards the
+	// configured rate after each successful request.
+	rateLimitRecoveryFactor = 1.1
+
+	// rateLimitDefaultRetryAfter is how long the limiter pauses after a 429
+	// response that doesn't include a Retry-After header.
+	rateLimitDefaultRetryAfter = time.Second
+)
+
+// rateLimiter is a token bucket shared by every sub-client, so that the
+// total rate of requests sent to the API stays under the configured limit.
+//
+// When the API responds with 429 anyway, the rate is halved and requests
+// are paused until the Retry-After delay has passed. The rate then grows
+// back towards the configured limit as requests succeed.
+type rateLimiter struct {
+	mu sync.Mutex
+
+	limit    float64
+	rate     float64
+	tokens   float64
+	last     time.Time
+	pausedTo time.Time
+}
+
+func newRateLimiter(requestsPerSecond float64) *rateLimiter {
+	return &rateLimiter{
+		limit:  requestsPerSecond,
+		rate:   requestsPerSecond,
+		tokens: 1,
+	}
+}
+
+// reserve takes a token from the bucket and returns how long the caller
+// needs to wait before sending its request.
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if !l.last.IsZero() {
+		l.tokens = math.Min(1, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	}
+	l.last = now
+	l.tokens--
+
+	var wait time.Duration
+	if l.tokens < 0 {
+		wait = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+
+	if paused := l.pausedTo.Sub(now); paused > wait {
+		wait = paused
+	}
+
+	return wait
+}
+
+// wait blocks until a request can be sent, or the context is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	wait := l.reserve()
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("waiting for rate limit: %w", ctx.Err())
+	case <-timer.C:
+		return nil
+	}
+}
+
+// backOff halves the rate and pauses requests for the given delay.
+func (l *rateLimiter) backOff(retryAfter time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.rate = math.Max(l.rate/2, l.limit*rateLimitMinFraction)
+
+	if pausedTo := time.Now().Add(retryAfter); pausedTo.After(l.pausedTo) {
+		l.pausedTo = pausedTo
+	}
+}
+
+// speedUp grows the rate back towards the configured limit.
+func (l *rateLimiter) speedUp() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.rate = math.Min(l.rate*rateLimitRecoveryFactor, l.limit)
+}
+
+// rateLimitedTransport is an http.RoundTripper that sends requests
+// through a rateLimiter, and retries requests rejected with 429.
+type rateLimitedTransport struct {
+	next    http.RoundTripper
+	limiter *rateLimiter
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *rateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if err := t.limiter.wait(req.Context()); err != nil {
+			return nil, err
+		}
+
+		resp, err := t.next.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode != http.StatusTooManyRequests {
+			t.limiter.speedUp()
+
+			return resp, nil
+		}
+
+		t.limiter.backOff(parseRetryAfter(resp.Header.Get("Retry-After")))
+
+		// Requests can only be retried if their body can be sent again.
+		if attempt == rateLimitMaxRetries || (req.Body != nil && req.Body != http.NoBody && req.GetBody == nil) {
+			return resp, nil
+		}
+
+		resp.Body.Close()
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("failed to rewind request body: %w", err)
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+// parseRetryAfter parses a Retry-After header, given either in seconds
+// or as an HTTP date.
+func parseRetryAfter(value string) time.Duration {
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date)
+	}
+
+	return rateLimitDefaultRetryAfter
+}
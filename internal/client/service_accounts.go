@@ -5,7 +5,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -20,7 +19,7 @@ type ServiceAccountsClient struct {
 
 //nolint:ireturn // required to support PrefectClient mocking
 func (c *Client) ServiceAccounts(accountID uuid.UUID) (api.ServiceAccountsClient, error) {
-	if c.apiKey == "" {
+	if c.apiKey == "" && c.apiKeyFile == "" {
 		return nil, fmt.Errorf("apiKey is not set")
 	}
 
@@ -63,9 +62,7 @@ func (sa *ServiceAccountsClient) Create(ctx context.Context, request api.Service
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return nil, errorFromResponse(resp)
 	}
 
 	var response api.ServiceAccount
@@ -80,36 +77,7 @@ func (sa *ServiceAccountsClient) List(ctx context.Context, names []string) ([]*a
 	filter := api.ServiceAccountFilter{}
 	filter.ServiceAccounts.Name.Any = names
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(&filter); err != nil {
-		return nil, fmt.Errorf("failed to encode filter: %w", err)
-	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sa.routePrefix+"/filter", &buf)
-	if err != nil {
-		return nil, fmt.Errorf("error creating request: %w", err)
-	}
-
-	setDefaultHeaders(req, sa.apiKey)
-
-	resp, err := sa.hc.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("http error: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
-	}
-
-	var serviceAccounts []*api.ServiceAccount
-	if err := json.NewDecoder(resp.Body).Decode(&serviceAccounts); err != nil { // THIS IS THE RESPONSE
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	return serviceAccounts, nil
+	return listAll[*api.ServiceAccount](ctx, sa.hc, sa.apiKey, sa.routePrefix+"/filter", &filter)
 }
 
 func (sa *ServiceAccountsClient) Get(ctx context.Context, botID string) (*api.ServiceAccount, error) {
@@ -131,9 +99,7 @@ func (sa *ServiceAccountsClient) Get(ctx context.Context, botID string) (*api.Se
 	case http.StatusNotFound:
 		return nil, fmt.Errorf("could not find Service Account")
 	default:
-		bodyBytes, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, string(bodyBytes))
+		return nil, errorFromResponse(resp)
 	}
 
 	var response api.ServiceAccount
@@ -164,9 +130,7 @@ func (sa *ServiceAccountsClient) Update(ctx context.Context, botID string, reque
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return errorFromResponse(resp)
 	}
 
 	return nil
@@ -186,9 +150,7 @@ func (sa *ServiceAccountsClient) Delete(ctx context.Context, botID string) error
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return errorFromResponse(resp)
 	}
 
 	return nil
@@ -213,9 +175,7 @@ func (sa *ServiceAccountsClient) RotateKey(ctx context.Context, serviceAccountID
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return nil, errorFromResponse(resp)
 	}
 
 	var serviceAccount api.ServiceAccount
@@ -0,0 +1,135 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+var _ = api.TagConcurrencyLimitsClient(&TagConcurrencyLimitsClient{})
+
+// TagConcurrencyLimitsClient is a client for working with tag-based concurrency limits.
+type TagConcurrencyLimitsClient struct {
+	hc          *http.Client
+	apiKey      string
+	routePrefix string
+}
+
+// TagConcurrencyLimits returns a TagConcurrencyLimitsClient.
+//
+//nolint:ireturn // required to support PrefectClient mocking
+func (c *Client) TagConcurrencyLimits(accountID uuid.UUID, workspaceID uuid.UUID) (api.TagConcurrencyLimitsClient, error) {
+	if accountID == uuid.Nil {
+		accountID = c.defaultAccountID
+	}
+	if workspaceID == uuid.Nil {
+		workspaceID = c.defaultWorkspaceID
+	}
+
+	return &TagConcurrencyLimitsClient{
+		hc:          c.hc,
+		apiKey:      c.apiKey,
+		routePrefix: getWorkspaceScopedURL(c.endpoint, accountID, workspaceID, "concurrency_limits"),
+	}, nil
+}
+
+// Upsert creates a concurrency limit for a tag, or updates the limit if
+// the tag already has one, and returns its details.
+func (c *TagConcurrencyLimitsClient) Upsert(ctx context.Context, data api.TagConcurrencyLimitUpsert) (*api.TagConcurrencyLimit, error) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&data); err != nil {
+		return nil, fmt.Errorf("failed to encode data: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.routePrefix+"/", &buf)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// The API responds with 200 rather than 201 when the tag already had a limit.
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, errorFromResponse(resp)
+	}
+
+	var limit api.TagConcurrencyLimit
+	if err := json.NewDecoder(resp.Body).Decode(&limit); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &limit, nil
+}
+
+// Get returns details for a tag-based concurrency limit by ID.
+func (c *TagConcurrencyLimitsClient) Get(ctx context.Context, limitID uuid.UUID) (*api.TagConcurrencyLimit, error) {
+	return c.get(ctx, c.routePrefix+"/"+limitID.String())
+}
+
+// GetByTag returns details for a tag-based concurrency limit by tag.
+func (c *TagConcurrencyLimitsClient) GetByTag(ctx context.Context, tag string) (*api.TagConcurrencyLimit, error) {
+	return c.get(ctx, c.routePrefix+"/tag/"+url.PathEscape(tag))
+}
+
+func (c *TagConcurrencyLimitsClient) get(ctx context.Context, route string) (*api.TagConcurrencyLimit, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, route, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errorFromResponse(resp)
+	}
+
+	var limit api.TagConcurrencyLimit
+	if err := json.NewDecoder(resp.Body).Decode(&limit); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &limit, nil
+}
+
+// Delete removes a tag-based concurrency limit by ID. A limit that no
+// longer exists is treated as already deleted.
+func (c *TagConcurrencyLimitsClient) Delete(ctx context.Context, limitID uuid.UUID) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.routePrefix+"/"+limitID.String(), http.NoBody)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		return errorFromResponse(resp)
+	}
+}
@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// DefaultRequestTimeout is how long a request to the API may take, including
+// reading the response body, unless configured otherwise.
+const DefaultRequestTimeout = 60 * time.Second
+
+// timeoutTransport is an http.RoundTripper that bounds how long each request
+// takes, so a hung endpoint fails the request rather than stalling forever.
+//
+// Unlike http.Client.Timeout, a timeout is reported along with the duration
+// and how to raise it, rather than as a bare context error.
+type timeoutTransport struct {
+	next    http.RoundTripper
+	timeout time.Duration
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *timeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+
+		return nil, t.wrapError(ctx, req, err)
+	}
+
+	// The deadline applies until the body is read, so it's only released
+	// once the body is closed.
+	resp.Body = &timeoutBody{
+		ReadCloser: resp.Body,
+		ctx:        ctx,
+		cancel:     cancel,
+		transport:  t,
+		req:        req,
+	}
+
+	return resp, nil
+}
+
+// wrapError describes errors caused by the request timing out, rather than
+// by its own context being canceled. The http.Client adds the method and URL
+// to errors returned by RoundTrip.
+func (t *timeoutTransport) wrapError(ctx context.Context, req *http.Request, err error) error {
+	if !errors.Is(ctx.Err(), context.DeadlineExceeded) || req.Context().Err() != nil {
+		return err
+	}
+
+	return fmt.Errorf("timed out after %s, which can be raised with the provider's request_timeout: %w", t.timeout, err)
+}
+
+// timeoutBody releases the request's deadline once the response body is closed.
+type timeoutBody struct {
+	io.ReadCloser
+	ctx       context.Context
+	cancel    context.CancelFunc
+	transport *timeoutTransport
+	req       *http.Request
+}
+
+func (b *timeoutBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil && !errors.Is(err, io.EOF) && errors.Is(b.ctx.Err(), context.DeadlineExceeded) {
+		err = fmt.Errorf("reading response from %s %q: %w", b.req.Method, b.req.URL.Redacted(), b.transport.wrapError(b.ctx, b.req, err))
+	}
+
+	return n, err
+}
+
+func (b *timeoutBody) Close() error {
+	defer b.cancel()
+
+	return b.ReadCloser.Close()
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -10,8 +11,15 @@ type Client struct {
 	hc                 *http.Client
 	endpoint           string
 	apiKey             string
+	apiKeyFile         string
 	defaultAccountID   uuid.UUID
 	defaultWorkspaceID uuid.UUID
+	httpHeaders        map[string]string
+
+	rateLimit      float64
+	requestTimeout time.Duration
+
+	serverVersion *serverVersion
 }
 
 type Option func(c *Client) error
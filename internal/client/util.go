@@ -2,44 +2,43 @@ package client
 
 import (
 	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/google/uuid"
 )
 
-// getAccountScopedURL constructs a URL for an account-scoped route.
-func getAccountScopedURL(endpoint string, accountID uuid.UUID, route string) string {
-	var builder strings.Builder
-
-	builder.WriteString(endpoint)
+// joinURL joins path elements to an endpoint, so the URL is the same
+// whether or not the endpoint or the elements have leading or trailing
+// slashes. A trailing slash is kept when the last element has one, or is
+// empty, as some routes require it.
+func joinURL(endpoint string, elements ...string) string {
+	joined, err := url.JoinPath(endpoint, elements...)
+	if err != nil {
+		// The endpoint is validated when configuring the client, so this
+		// can only happen for a client that wasn't configured with one.
+		return strings.TrimRight(endpoint, "/") + "/" + strings.Join(elements, "/")
+	}
 
-	builder.WriteString("/accounts/")
-	builder.WriteString(accountID.String())
-	builder.WriteRune('/')
+	if len(elements) > 0 && elements[len(elements)-1] == "" && !strings.HasSuffix(joined, "/") {
+		joined += "/"
+	}
 
-	builder.WriteString(route)
+	return joined
+}
 
-	return builder.String()
+// getAccountScopedURL constructs a URL for an account-scoped route.
+func getAccountScopedURL(endpoint string, accountID uuid.UUID, route string) string {
+	return joinURL(endpoint, "accounts", accountID.String(), route)
 }
 
 // getWorkspaceScopedURL constructs a URL for a workspace-scoped route.
 func getWorkspaceScopedURL(endpoint string, accountID uuid.UUID, workspaceID uuid.UUID, route string) string {
-	var builder strings.Builder
-
-	builder.WriteString(endpoint)
-
 	if accountID != uuid.Nil && workspaceID != uuid.Nil {
-		builder.WriteString("/accounts/")
-		builder.WriteString(accountID.String())
-
-		builder.WriteString("/workspaces/")
-		builder.WriteString(workspaceID.String())
+		return joinURL(endpoint, "accounts", accountID.String(), "workspaces", workspaceID.String(), route)
 	}
 
-	builder.WriteRune('/')
-	builder.WriteString(route)
-
-	return builder.String()
+	return joinURL(endpoint, route)
 }
 
 // setAuthorizationHeader will set the Authorization header to the
@@ -5,7 +5,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -61,9 +60,7 @@ func (c *VariablesClient) Create(ctx context.Context, data api.VariableCreate) (
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return nil, errorFromResponse(resp)
 	}
 
 	var variable api.Variable
@@ -76,10 +73,9 @@ func (c *VariablesClient) Create(ctx context.Context, data api.VariableCreate) (
 
 // List returns a list of variables matching filter criteria.
 func (c *VariablesClient) List(ctx context.Context, filter api.VariableFilter) ([]api.Variable, error) {
-	_ = ctx
-	_ = filter
+	filterQuery := api.VariableFilterSettings{Variables: &filter}
 
-	return nil, nil
+	return listAll[api.Variable](ctx, c.hc, c.apiKey, c.routePrefix+"/filter", &filterQuery)
 }
 
 // Get returns details for a variable by ID.
@@ -98,9 +94,7 @@ func (c *VariablesClient) Get(ctx context.Context, variableID uuid.UUID) (*api.V
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return nil, errorFromResponse(resp)
 	}
 
 	var variable api.Variable
@@ -127,9 +121,7 @@ func (c *VariablesClient) GetByName(ctx context.Context, name string) (*api.Vari
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return nil, errorFromResponse(resp)
 	}
 
 	var variable api.Variable
@@ -161,9 +153,7 @@ func (c *VariablesClient) Update(ctx context.Context, variableID uuid.UUID, data
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return errorFromResponse(resp)
 	}
 
 	return nil
@@ -185,9 +175,7 @@ func (c *VariablesClient) Delete(ctx context.Context, variableID uuid.UUID) erro
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return errorFromResponse(resp)
 	}
 
 	return nil
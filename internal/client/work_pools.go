@@ -5,7 +5,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -60,9 +59,7 @@ func (c *WorkPoolsClient) Create(ctx context.Context, data api.WorkPoolCreate) (
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return nil, errorFromResponse(resp)
 	}
 
 	var pool api.WorkPool
@@ -75,36 +72,7 @@ func (c *WorkPoolsClient) Create(ctx context.Context, data api.WorkPoolCreate) (
 
 // List returns a list of work pools matching filter criteria.
 func (c *WorkPoolsClient) List(ctx context.Context, filter api.WorkPoolFilter) ([]*api.WorkPool, error) {
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(&filter); err != nil {
-		return nil, fmt.Errorf("failed to encode filter: %w", err)
-	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.routePrefix+"/filter", &buf)
-	if err != nil {
-		return nil, fmt.Errorf("error creating request: %w", err)
-	}
-
-	setDefaultHeaders(req, c.apiKey)
-
-	resp, err := c.hc.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("http error: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
-	}
-
-	var pools []*api.WorkPool
-	if err := json.NewDecoder(resp.Body).Decode(&pools); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	return pools, nil
+	return listAll[*api.WorkPool](ctx, c.hc, c.apiKey, c.routePrefix+"/filter", &filter)
 }
 
 // Get returns details for a work pool by name.
@@ -123,9 +91,7 @@ func (c *WorkPoolsClient) Get(ctx context.Context, name string) (*api.WorkPool,
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return nil, errorFromResponse(resp)
 	}
 
 	var pool api.WorkPool
@@ -157,9 +123,7 @@ func (c *WorkPoolsClient) Update(ctx context.Context, name string, data api.Work
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return errorFromResponse(resp)
 	}
 
 	return nil
@@ -181,10 +145,18 @@ func (c *WorkPoolsClient) Delete(ctx context.Context, name string) error {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return errorFromResponse(resp)
 	}
 
 	return nil
 }
+
+// ListQueues returns the work queues of a work pool.
+func (c *WorkPoolsClient) ListQueues(ctx context.Context, name string) ([]*api.WorkQueue, error) {
+	return listAll[*api.WorkQueue](ctx, c.hc, c.apiKey, c.routePrefix+"/"+name+"/queues/filter", nil)
+}
+
+// ListWorkers returns the workers of a work pool.
+func (c *WorkPoolsClient) ListWorkers(ctx context.Context, name string) ([]*api.Worker, error) {
+	return listAll[*api.Worker](ctx, c.hc, c.apiKey, c.routePrefix+"/"+name+"/workers/filter", nil)
+}
@@ -5,7 +5,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -39,7 +38,7 @@ func (c *Client) WorkspaceAccess(accountID uuid.UUID, workspaceID uuid.UUID) (ap
 	return &WorkspaceAccessClient{
 		hc:          c.hc,
 		apiKey:      c.apiKey,
-		routePrefix: fmt.Sprintf("%s/accounts/%s/workspaces/%s", c.endpoint, accountID.String(), workspaceID.String()),
+		routePrefix: joinURL(c.endpoint, "accounts", accountID.String(), "workspaces", workspaceID.String()),
 	}, nil
 }
 
@@ -96,9 +95,7 @@ func (c *WorkspaceAccessClient) Upsert(ctx context.Context, accessorType string,
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return nil, errorFromResponse(resp)
 	}
 
 	var workspaceAccesses []api.WorkspaceAccess
@@ -151,9 +148,7 @@ func (c *WorkspaceAccessClient) Get(ctx context.Context, accessorType string, ac
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return nil, errorFromResponse(resp)
 	}
 
 	var workspaceAccess api.WorkspaceAccess
@@ -225,9 +220,7 @@ func (c *WorkspaceAccessClient) Delete(ctx context.Context, accessorType string,
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return errorFromResponse(resp)
 	}
 
 	return nil
@@ -32,6 +32,7 @@ type AccountDataSourceModel struct {
 	Link         types.String `tfsdk:"link"`
 	Settings     types.Object `tfsdk:"settings"`
 	BillingEmail types.String `tfsdk:"billing_email"`
+	PlanType     types.String `tfsdk:"plan_type"`
 }
 
 // NewAccountDataSource returns a new AccountDataSource.
@@ -52,14 +53,14 @@ func (d *AccountDataSource) Configure(_ context.Context, req datasource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(api.PrefectClient)
+	data, ok := req.ProviderData.(*helpers.ProviderData)
 	if !ok {
 		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("data source", req.ProviderData))
 
 		return
 	}
 
-	d.client = client
+	d.client = data.Client
 }
 
 // Schema defines the schema for the data source.
@@ -74,7 +75,7 @@ Use this data source to obtain account-level attributes
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				CustomType:  customtypes.UUIDType{},
-				Description: "Account ID (UUID)",
+				Description: "Account ID (UUID), defaults to the account set in the provider",
 				Optional:    true,
 			},
 			"created": schema.StringAttribute{
@@ -128,6 +129,10 @@ Use this data source to obtain account-level attributes
 				Computed:    true,
 				Description: "Billing email to apply to the account's Stripe customer",
 			},
+			"plan_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Type of plan the account is subscribed to",
+			},
 		},
 	}
 }
@@ -151,6 +156,8 @@ func (d *AccountDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	client, err := d.client.Accounts(accountID)
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Account", err))
+
+		return
 	}
 
 	account, err := client.Get(ctx)
@@ -188,6 +195,7 @@ func (d *AccountDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	model.Link = types.StringPointerValue(account.Link)
 	model.Location = types.StringPointerValue(account.Location)
 	model.Name = types.StringValue(account.Name)
+	model.PlanType = types.StringValue(account.PlanType)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
@@ -122,14 +122,14 @@ func (d *AccountMemberDataSource) Configure(_ context.Context, req datasource.Co
 		return
 	}
 
-	client, ok := req.ProviderData.(api.PrefectClient)
+	data, ok := req.ProviderData.(*helpers.ProviderData)
 	if !ok {
 		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("data source", req.ProviderData))
 
 		return
 	}
 
-	d.client = client
+	d.client = data.Client
 }
 
 // Read refreshes the Terraform state with the latest data.
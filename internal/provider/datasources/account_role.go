@@ -102,14 +102,14 @@ func (d *AccountRoleDataSource) Configure(_ context.Context, req datasource.Conf
 		return
 	}
 
-	client, ok := req.ProviderData.(api.PrefectClient)
+	data, ok := req.ProviderData.(*helpers.ProviderData)
 	if !ok {
 		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("data source", req.ProviderData))
 
 		return
 	}
 
-	d.client = client
+	d.client = data.Client
 }
 
 // Read refreshes the Terraform state with the latest data.
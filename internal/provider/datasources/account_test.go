@@ -17,6 +17,12 @@ data "prefect_account" "test" {
 	`, os.Getenv("PREFECT_CLOUD_ACCOUNT_ID"))
 }
 
+func fixtureAccAccountFromProvider() string {
+	return `
+data "prefect_account" "test" {}
+	`
+}
+
 //nolint:paralleltest // we use the resource.ParallelTest helper instead
 func TestAccDatasource_account(t *testing.T) {
 	datasourceName := "data.prefect_account.test"
@@ -30,6 +36,16 @@ func TestAccDatasource_account(t *testing.T) {
 					resource.TestCheckResourceAttr(datasourceName, "id", os.Getenv("PREFECT_CLOUD_ACCOUNT_ID")),
 					resource.TestCheckResourceAttrSet(datasourceName, "name"),
 					resource.TestCheckResourceAttrSet(datasourceName, "handle"),
+					resource.TestCheckResourceAttrSet(datasourceName, "plan_type"),
+				),
+			},
+			{
+				// Without an ID, the account set in the provider is used.
+				Config: fixtureAccAccountFromProvider(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "id", os.Getenv("PREFECT_CLOUD_ACCOUNT_ID")),
+					resource.TestCheckResourceAttrSet(datasourceName, "name"),
+					resource.TestCheckResourceAttrSet(datasourceName, "plan_type"),
 				),
 			},
 		},
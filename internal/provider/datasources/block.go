@@ -3,10 +3,14 @@ package datasources
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"strings"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
@@ -35,6 +39,9 @@ type BlockDataSourceModel struct {
 	Name     types.String         `tfsdk:"name"`
 	Data     jsontypes.Normalized `tfsdk:"data"`
 	TypeSlug types.String         `tfsdk:"type_slug"`
+
+	Capabilities         types.List `tfsdk:"capabilities"`
+	RequiredCapabilities types.List `tfsdk:"required_capabilities"`
 }
 
 // NewBlockDataSource is a helper function to simplify the provider implementation.
@@ -104,6 +111,16 @@ Use this data source to obtain Block-specific attributes, such as the data.
 				Description: "Block type slug",
 				Optional:    true,
 			},
+			"capabilities": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Capabilities supported by the Block's schema, e.g. `read-path` or `write-path`",
+			},
+			"required_capabilities": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Capabilities the Block's schema must support. An error is returned if any of them are missing, which prevents wiring an incompatible Block into e.g. a deployment.",
+			},
 		},
 	}
 }
@@ -163,24 +180,94 @@ func (d *blockDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	state.Data = jsontypes.NewNormalizedValue(string(byteSlice))
 
+	capabilities, err := d.blockCapabilities(ctx, &state, block)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Block Schema", "list", err))
+
+		return
+	}
+
+	capabilitiesValue, diags := types.ListValueFrom(ctx, types.StringType, capabilities)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Capabilities = capabilitiesValue
+
+	var requiredCapabilities []string
+	resp.Diagnostics.Append(state.RequiredCapabilities.ElementsAs(ctx, &requiredCapabilities, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	supported := make(map[string]bool, len(capabilities))
+	for _, capability := range capabilities {
+		supported[capability] = true
+	}
+
+	var missing []string
+	for _, capability := range requiredCapabilities {
+		if !supported[capability] {
+			missing = append(missing, capability)
+		}
+	}
+
+	if len(missing) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("required_capabilities"),
+			"Block is missing required capabilities",
+			fmt.Sprintf("Block %q of type %q does not support the required capabilities: %s. Supported capabilities: %s",
+				block.Name, block.BlockType.Slug, strings.Join(missing, ", "), strings.Join(capabilities, ", ")),
+		)
+
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
+// blockCapabilities returns the capabilities of the Block's schema.
+// The schema is usually embedded in the Block document, but we'll
+// fall back to looking it up by Block type if it's missing.
+func (d *blockDataSource) blockCapabilities(ctx context.Context, state *BlockDataSourceModel, block *api.BlockDocument) ([]string, error) {
+	if block.BlockSchema != nil {
+		return block.BlockSchema.Capabilities, nil
+	}
+
+	client, err := d.client.BlockSchemas(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create block schema client: %w", err)
+	}
+
+	blockSchemas, err := client.List(ctx, []uuid.UUID{block.BlockTypeID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list block schemas: %w", err)
+	}
+
+	for _, blockSchema := range blockSchemas {
+		if blockSchema.ID == block.BlockSchemaID {
+			return blockSchema.Capabilities, nil
+		}
+	}
+
+	return nil, fmt.Errorf("block schema %s not found for block type %s", block.BlockSchemaID, block.BlockTypeID)
+}
+
 // Configure initializes runtime state for the data source.
 func (d *blockDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(api.PrefectClient)
+	data, ok := req.ProviderData.(*helpers.ProviderData)
 	if !ok {
 		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("data source", req.ProviderData))
 
 		return
 	}
 
-	d.client = client
+	d.client = data.Client
 }
@@ -3,6 +3,7 @@ package datasources_test
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -50,6 +51,34 @@ data "prefect_block" "my_existing_secret_by_name" {
 `, name, name, aID, name, aID, name, name, aID, name)
 }
 
+func fixtureAccBlockWithRequiredCapabilities(name string) string {
+	return fmt.Sprintf(`
+data "prefect_workspace" "evergreen" {
+	handle = "github-ci-tests"
+}
+
+resource "prefect_block" "%s" {
+  name      = "%s"
+  type_slug = "secret"
+
+  data = jsonencode({
+    "someKey" = "someValue"
+  })
+
+  workspace_id = data.prefect_workspace.evergreen.id
+}
+
+data "prefect_block" "my_existing_secret_with_capabilities" {
+  id = prefect_block.%s.id
+  required_capabilities = ["write-path"]
+
+  workspace_id = data.prefect_workspace.evergreen.id
+
+  depends_on = [prefect_block.%s]
+}
+`, name, name, name, name)
+}
+
 //nolint:paralleltest // we use the resource.ParallelTest helper instead
 func TestAccDatasource_block(t *testing.T) {
 	datasourceNameByID := "data.prefect_block.my_existing_secret_by_id"
@@ -83,3 +112,18 @@ func TestAccDatasource_block(t *testing.T) {
 		},
 	})
 }
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccDatasource_block_required_capabilities(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Test that a Block type missing a required capability is rejected.
+				Config:      fixtureAccBlockWithRequiredCapabilities(testutils.NewRandomPrefixedString()),
+				ExpectError: regexp.MustCompile("Block is missing required capabilities"),
+			},
+		},
+	})
+}
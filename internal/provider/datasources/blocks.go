@@ -0,0 +1,198 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = datasource.DataSourceWithConfigure(&BlocksDataSource{})
+
+// BlocksDataSource contains state for the data source.
+type BlocksDataSource struct {
+	client api.PrefectClient
+}
+
+// BlocksDataSourceModel defines the Terraform data source model.
+type BlocksDataSourceModel struct {
+	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+
+	TypeSlug types.String `tfsdk:"type_slug"`
+	Blocks   types.List   `tfsdk:"blocks"`
+}
+
+// NewBlocksDataSource returns a new BlocksDataSource.
+//
+//nolint:ireturn // required by Terraform API
+func NewBlocksDataSource() datasource.DataSource {
+	return &BlocksDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *BlocksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blocks"
+}
+
+// Configure initializes runtime state for the data source.
+func (d *BlocksDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*helpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("data source", req.ProviderData))
+
+		return
+	}
+
+	d.client = data.Client
+}
+
+// Schema defines the schema for the data source.
+func (d *BlocksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Get information about multiple Blocks.
+<br>
+Use this data source to list the named Blocks in a Workspace, optionally only those of one block type.
+The Block data itself is not returned; use the ` + "`prefect_block`" + ` data source to read a Block's data.
+`,
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"type_slug": schema.StringAttribute{
+				Description: "Only return Blocks of this block type, e.g. `secret`",
+				Optional:    true,
+			},
+			"blocks": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Blocks returned by the server",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.UUIDType{},
+							Description: "Block ID (UUID)",
+						},
+						"created": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.TimestampType{},
+							Description: "Timestamp of when the resource was created (RFC3339)",
+						},
+						"updated": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.TimestampType{},
+							Description: "Timestamp of when the resource was updated (RFC3339)",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the block",
+						},
+						"type_slug": schema.StringAttribute{
+							Computed:    true,
+							Description: "Block type slug",
+						},
+						"block_type_id": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.UUIDType{},
+							Description: "Block type ID (UUID)",
+						},
+						"block_schema_id": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.UUIDType{},
+							Description: "Block schema ID (UUID)",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *BlocksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model BlocksDataSourceModel
+
+	// Populate the model from data source configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var typeSlugs []string
+	if !model.TypeSlug.IsNull() {
+		typeSlugs = []string{model.TypeSlug.ValueString()}
+	}
+
+	client, err := d.client.BlockDocuments(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Block", err))
+
+		return
+	}
+
+	blocks, err := client.List(ctx, typeSlugs)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Blocks", "list", err))
+
+		return
+	}
+
+	attributeTypes := map[string]attr.Type{
+		"id":              customtypes.UUIDType{},
+		"created":         customtypes.TimestampType{},
+		"updated":         customtypes.TimestampType{},
+		"name":            types.StringType,
+		"type_slug":       types.StringType,
+		"block_type_id":   customtypes.UUIDType{},
+		"block_schema_id": customtypes.UUIDType{},
+	}
+
+	blockObjects := make([]attr.Value, 0, len(blocks))
+	for _, block := range blocks {
+		blockObject, diags := types.ObjectValue(attributeTypes, map[string]attr.Value{
+			"id":              customtypes.NewUUIDValue(block.ID),
+			"created":         customtypes.NewTimestampPointerValue(block.Created),
+			"updated":         customtypes.NewTimestampPointerValue(block.Updated),
+			"name":            types.StringValue(block.Name),
+			"type_slug":       types.StringValue(block.BlockType.Slug),
+			"block_type_id":   customtypes.NewUUIDValue(block.BlockTypeID),
+			"block_schema_id": customtypes.NewUUIDValue(block.BlockSchemaID),
+		})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		blockObjects = append(blockObjects, blockObject)
+	}
+
+	list, diags := types.ListValue(types.ObjectType{AttrTypes: attributeTypes}, blockObjects)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	model.Blocks = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,72 @@
+package datasources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccBlocks(workspace, workspaceName, firstName, secondName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "prefect_block" "first" {
+	name = "%s"
+	type_slug = "secret"
+	data = jsonencode({ "value" = "first" })
+	workspace_id = prefect_workspace.%s.id
+}
+
+resource "prefect_block" "second" {
+	name = "%s"
+	type_slug = "secret"
+	data = jsonencode({ "value" = "second" })
+	workspace_id = prefect_workspace.%s.id
+}
+
+data "prefect_blocks" "secrets" {
+	type_slug = "secret"
+	workspace_id = prefect_workspace.%s.id
+	depends_on = [prefect_block.first, prefect_block.second]
+}
+
+data "prefect_blocks" "none" {
+	type_slug = "json"
+	workspace_id = prefect_workspace.%s.id
+	depends_on = [prefect_block.first, prefect_block.second]
+}
+`, workspace, firstName, workspaceName, secondName, workspaceName, workspaceName, workspaceName)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccDatasource_blocks(t *testing.T) {
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+	firstName := testutils.NewRandomPrefixedString()
+	secondName := testutils.NewRandomPrefixedString()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccBlocks(workspace, workspaceName, firstName, secondName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.prefect_blocks.secrets", "blocks.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.prefect_blocks.secrets", "blocks.*", map[string]string{
+						"name":      firstName,
+						"type_slug": "secret",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.prefect_blocks.secrets", "blocks.*", map[string]string{
+						"name":      secondName,
+						"type_slug": "secret",
+					}),
+					resource.TestCheckTypeSetElemAttrPair("data.prefect_blocks.secrets", "blocks.*.id", "prefect_block.first", "id"),
+					resource.TestCheckResourceAttrSet("data.prefect_blocks.secrets", "blocks.0.block_schema_id"),
+					resource.TestCheckResourceAttr("data.prefect_blocks.none", "blocks.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,191 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = datasource.DataSourceWithConfigure(&DeploymentValidationDataSource{})
+
+// DeploymentValidationDataSource contains state for the data source.
+type DeploymentValidationDataSource struct {
+	client api.PrefectClient
+}
+
+// DeploymentValidationDataSourceModel defines the Terraform data source model.
+type DeploymentValidationDataSourceModel struct {
+	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+
+	FlowID          customtypes.UUIDValue `tfsdk:"flow_id"`
+	Entrypoint      types.String          `tfsdk:"entrypoint"`
+	Parameters      jsontypes.Normalized  `tfsdk:"parameters"`
+	ParameterSchema jsontypes.Normalized  `tfsdk:"parameter_openapi_schema"`
+
+	Valid    types.Bool `tfsdk:"valid"`
+	Messages types.List `tfsdk:"messages"`
+}
+
+// NewDeploymentValidationDataSource returns a new DeploymentValidationDataSource.
+//
+//nolint:ireturn // required by Terraform API
+func NewDeploymentValidationDataSource() datasource.DataSource {
+	return &DeploymentValidationDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *DeploymentValidationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_validation"
+}
+
+// Configure initializes runtime state for the data source.
+func (d *DeploymentValidationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*helpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("data source", req.ProviderData))
+
+		return
+	}
+
+	d.client = data.Client
+}
+
+// Schema defines the schema for the data source.
+func (d *DeploymentValidationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Validate a Deployment configuration without creating anything.
+<br>
+Use this data source to check a Deployment's configuration in CI at plan time, before it's applied.
+The Flow is looked up in the Workspace, the entrypoint's format is checked, and the parameters are checked against the parameter schema
+the same way ` + "`prefect_deployment`" + ` does when ` + "`enforce_parameter_schema`" + ` is set.
+A configuration that doesn't pass validation doesn't fail the plan: check ` + "`valid`" + ` and ` + "`messages`" + ` instead, e.g. in a precondition.
+`,
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"flow_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Flow ID (UUID) of the deployment",
+				Required:    true,
+			},
+			"entrypoint": schema.StringAttribute{
+				Description: "The path to the entrypoint for the workflow, e.g. `flows/etl.py:etl`",
+				Optional:    true,
+			},
+			"parameters": schema.StringAttribute{
+				CustomType:  jsontypes.NormalizedType{},
+				Description: "Parameters for flow runs scheduled by the deployment, as a JSON string",
+				Optional:    true,
+			},
+			"parameter_openapi_schema": schema.StringAttribute{
+				CustomType:  jsontypes.NormalizedType{},
+				Description: "The OpenAPI schema of the flow's parameters, as a JSON string. Parameters are only validated when it's set",
+				Optional:    true,
+			},
+			"valid": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the deployment configuration passed validation",
+			},
+			"messages": schema.ListAttribute{
+				Computed:    true,
+				Description: "Why the deployment configuration didn't pass validation, prefixed with the attribute or parameter at fault",
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *DeploymentValidationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model DeploymentValidationDataSourceModel
+
+	// Populate the model from data source configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.Flows(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Flow", err))
+
+		return
+	}
+
+	messages := make([]string, 0)
+
+	if _, err := client.Get(ctx, model.FlowID.ValueUUID()); err != nil {
+		messages = append(messages, fmt.Sprintf("flow_id: the flow %s doesn't exist or can't be read: %s", model.FlowID.ValueString(), err))
+	}
+
+	if !model.Entrypoint.IsNull() {
+		if message := helpers.ValidateEntrypoint(model.Entrypoint.ValueString()); message != "" {
+			messages = append(messages, "entrypoint: "+message)
+		}
+	}
+
+	messages = append(messages, validateDeploymentParameters(model.Parameters, model.ParameterSchema)...)
+
+	list, diags := types.ListValueFrom(ctx, types.StringType, messages)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	model.Messages = list
+	model.Valid = types.BoolValue(len(messages) == 0)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// validateDeploymentParameters returns why parameters don't match a parameter
+// schema, if one is set. Parameters that aren't set are validated as empty,
+// so required parameters are reported.
+func validateDeploymentParameters(parameters, parameterSchema jsontypes.Normalized) []string {
+	if parameterSchema.IsNull() {
+		return nil
+	}
+
+	var decodedSchema map[string]interface{}
+	if diags := parameterSchema.Unmarshal(&decodedSchema); diags.HasError() {
+		return []string{"parameter_openapi_schema: must be a JSON object"}
+	}
+
+	decodedParameters := map[string]interface{}{}
+	if !parameters.IsNull() {
+		if diags := parameters.Unmarshal(&decodedParameters); diags.HasError() {
+			return []string{"parameters: must be a JSON object"}
+		}
+	}
+
+	messages := make([]string, 0)
+	for _, violation := range helpers.ValidateParameters(decodedSchema, decodedParameters) {
+		messages = append(messages, fmt.Sprintf("%s: %s", violation.Path, violation.Message))
+	}
+
+	return messages
+}
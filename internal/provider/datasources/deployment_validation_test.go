@@ -0,0 +1,103 @@
+package datasources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccDeploymentValidation(workspace, workspaceName, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "prefect_flow" "%s" {
+	name = "%s"
+	workspace_id = prefect_workspace.%s.id
+}
+
+locals {
+	parameter_schema = jsonencode({
+		type = "object"
+		properties = {
+			region = { type = "string" }
+			retries = { type = "integer" }
+		}
+		required = ["region"]
+	})
+}
+
+data "prefect_deployment_validation" "valid" {
+	flow_id = prefect_flow.%s.id
+	entrypoint = "flows/etl.py:etl"
+	parameters = jsonencode({ region = "us-east-1", retries = 3 })
+	parameter_openapi_schema = local.parameter_schema
+	workspace_id = prefect_workspace.%s.id
+}
+
+data "prefect_deployment_validation" "invalid" {
+	flow_id = prefect_flow.%s.id
+	entrypoint = "flows/etl.py"
+	parameters = jsonencode({ retries = "three" })
+	parameter_openapi_schema = local.parameter_schema
+	workspace_id = prefect_workspace.%s.id
+}
+`, workspace, name, name, workspaceName, name, workspaceName, name, workspaceName)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccDatasource_deployment_validation(t *testing.T) {
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+	randomName := testutils.NewRandomPrefixedString()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccDeploymentValidation(workspace, workspaceName, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.prefect_deployment_validation.valid", "valid", "true"),
+					resource.TestCheckResourceAttr("data.prefect_deployment_validation.valid", "messages.#", "0"),
+
+					resource.TestCheckResourceAttr("data.prefect_deployment_validation.invalid", "valid", "false"),
+					resource.TestCheckResourceAttr("data.prefect_deployment_validation.invalid", "messages.#", "3"),
+					resource.TestCheckTypeSetElemAttr("data.prefect_deployment_validation.invalid", "messages.*", "entrypoint: must be a file path and flow function separated by a colon, e.g. `flows/etl.py:etl`, or a dotted module path to the flow function, e.g. `flows.etl.etl`"),
+					resource.TestCheckTypeSetElemAttr("data.prefect_deployment_validation.invalid", "messages.*", "parameters.region: required parameter is missing"),
+				),
+			},
+		},
+	})
+}
+
+func TestEntrypointValidationHelper(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		entrypoint string
+		valid      bool
+	}{
+		{"flows/etl.py:etl", true},
+		{`C:\flows\etl.py:etl`, true},
+		{"flows/etl.py:Pipeline.run", true},
+		{"flows.etl.etl", true},
+		{"", false},
+		{"flows/etl.py", false},
+		{"flows/etl.py:", false},
+		{":etl", false},
+		{"flows/etl.py:etl-flow", false},
+		{"etl", false},
+	}
+
+	for _, c := range cases {
+		message := helpers.ValidateEntrypoint(c.entrypoint)
+		if c.valid && message != "" {
+			t.Fatalf("entrypoint %q should be valid, but got %q", c.entrypoint, message)
+		}
+		if !c.valid && message == "" {
+			t.Fatalf("entrypoint %q should be invalid", c.entrypoint)
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = datasource.DataSourceWithConfigure(&DeploymentsDataSource{})
+
+// DeploymentsDataSource contains state for the data source.
+type DeploymentsDataSource struct {
+	client api.PrefectClient
+}
+
+// DeploymentsDataSourceModel defines the Terraform data source model.
+type DeploymentsDataSourceModel struct {
+	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+
+	FlowID      customtypes.UUIDValue `tfsdk:"flow_id"`
+	Name        types.String          `tfsdk:"name"`
+	Tags        types.List            `tfsdk:"tags"`
+	Deployments types.List            `tfsdk:"deployments"`
+}
+
+// NewDeploymentsDataSource returns a new DeploymentsDataSource.
+//
+//nolint:ireturn // required by Terraform API
+func NewDeploymentsDataSource() datasource.DataSource {
+	return &DeploymentsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *DeploymentsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployments"
+}
+
+// Configure initializes runtime state for the data source.
+func (d *DeploymentsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*helpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("data source", req.ProviderData))
+
+		return
+	}
+
+	d.client = data.Client
+}
+
+// Schema defines the schema for the data source.
+func (d *DeploymentsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Get information about multiple Deployments.
+<br>
+Use this data source to search for Deployments by flow, name or tags. Defaults to fetching all Deployments in the Workspace.
+`,
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"flow_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Only return Deployments of this Flow (UUID)",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Only return Deployments with this name",
+				Optional:    true,
+			},
+			"tags": schema.ListAttribute{
+				Description: "Only return Deployments that have all of these tags",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"deployments": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Deployments returned by the server",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.UUIDType{},
+							Description: "Deployment ID (UUID)",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the deployment",
+						},
+						"flow_id": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.UUIDType{},
+							Description: "Flow ID (UUID) of the deployment",
+						},
+						"tags": schema.ListAttribute{
+							Computed:    true,
+							Description: "Tags associated with the deployment",
+							ElementType: types.StringType,
+						},
+						"paused": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the deployment is paused",
+						},
+						"work_pool_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the deployment's work pool",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *DeploymentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model DeploymentsDataSourceModel
+
+	// Populate the model from data source configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := api.DeploymentFilter{}
+	if !model.FlowID.IsNull() {
+		filter.Flows = &api.DeploymentFilterFlows{
+			ID: &api.DeploymentFilterAnyID{Any: []uuid.UUID{model.FlowID.ValueUUID()}},
+		}
+	}
+
+	if !model.Name.IsNull() || !model.Tags.IsNull() {
+		filter.Deployments = &api.DeploymentFilterDeployments{}
+	}
+	if !model.Name.IsNull() {
+		filter.Deployments.Name = &api.DeploymentFilterAnyName{Any: []string{model.Name.ValueString()}}
+	}
+	if !model.Tags.IsNull() {
+		var tags []string
+		resp.Diagnostics.Append(model.Tags.ElementsAs(ctx, &tags, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		filter.Deployments.Tags = &api.DeploymentFilterAllTags{All: tags}
+	}
+
+	client, err := d.client.Deployments(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Deployment", err))
+
+		return
+	}
+
+	deployments, err := client.List(ctx, filter)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Deployments", "list", err))
+
+		return
+	}
+
+	attributeTypes := map[string]attr.Type{
+		"id":             customtypes.UUIDType{},
+		"name":           types.StringType,
+		"flow_id":        customtypes.UUIDType{},
+		"tags":           types.ListType{ElemType: types.StringType},
+		"paused":         types.BoolType,
+		"work_pool_name": types.StringType,
+	}
+
+	deploymentObjects := make([]attr.Value, 0, len(deployments))
+	for _, deployment := range deployments {
+		tags, diags := types.ListValueFrom(ctx, types.StringType, deployment.Tags)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		deploymentObject, diags := types.ObjectValue(attributeTypes, map[string]attr.Value{
+			"id":             customtypes.NewUUIDValue(deployment.ID),
+			"name":           types.StringValue(deployment.Name),
+			"flow_id":        customtypes.NewUUIDValue(deployment.FlowID),
+			"tags":           tags,
+			"paused":         types.BoolValue(deployment.Paused),
+			"work_pool_name": types.StringValue(deployment.WorkPoolName),
+		})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		deploymentObjects = append(deploymentObjects, deploymentObject)
+	}
+
+	list, diags := types.ListValue(types.ObjectType{AttrTypes: attributeTypes}, deploymentObjects)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	model.Deployments = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,78 @@
+package datasources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccDeployments(workspace, workspaceName, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "prefect_flow" "%s" {
+	name = "%s"
+	workspace_id = prefect_workspace.%s.id
+}
+
+resource "prefect_deployment" "tagged" {
+	name = "%s-tagged"
+	flow_id = prefect_flow.%s.id
+	tags = ["dashboard", "team-a"]
+	workspace_id = prefect_workspace.%s.id
+}
+
+resource "prefect_deployment" "untagged" {
+	name = "%s-untagged"
+	flow_id = prefect_flow.%s.id
+	workspace_id = prefect_workspace.%s.id
+}
+
+data "prefect_deployments" "by_flow" {
+	flow_id = prefect_flow.%s.id
+	workspace_id = prefect_workspace.%s.id
+	depends_on = [prefect_deployment.tagged, prefect_deployment.untagged]
+}
+
+data "prefect_deployments" "by_tags" {
+	tags = ["dashboard"]
+	workspace_id = prefect_workspace.%s.id
+	depends_on = [prefect_deployment.tagged, prefect_deployment.untagged]
+}
+
+data "prefect_deployments" "by_name" {
+	name = prefect_deployment.untagged.name
+	workspace_id = prefect_workspace.%s.id
+}
+`, workspace, name, name, workspaceName, name, name, workspaceName, name, name, workspaceName, name, workspaceName, workspaceName, workspaceName)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccDatasource_deployments(t *testing.T) {
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+	randomName := testutils.NewRandomPrefixedString()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccDeployments(workspace, workspaceName, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.prefect_deployments.by_flow", "deployments.#", "2"),
+
+					resource.TestCheckResourceAttr("data.prefect_deployments.by_tags", "deployments.#", "1"),
+					resource.TestCheckResourceAttrPair("data.prefect_deployments.by_tags", "deployments.0.id", "prefect_deployment.tagged", "id"),
+					resource.TestCheckResourceAttr("data.prefect_deployments.by_tags", "deployments.0.tags.#", "2"),
+					resource.TestCheckResourceAttr("data.prefect_deployments.by_tags", "deployments.0.paused", "false"),
+
+					resource.TestCheckResourceAttr("data.prefect_deployments.by_name", "deployments.#", "1"),
+					resource.TestCheckResourceAttr("data.prefect_deployments.by_name", "deployments.0.name", randomName+"-untagged"),
+					resource.TestCheckResourceAttrPair("data.prefect_deployments.by_name", "deployments.0.flow_id", "prefect_flow."+randomName, "id"),
+				),
+			},
+		},
+	})
+}
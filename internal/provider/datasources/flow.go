@@ -0,0 +1,152 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = datasource.DataSourceWithConfigure(&FlowDataSource{})
+
+// FlowDataSource contains state for the data source.
+type FlowDataSource struct {
+	client api.PrefectClient
+}
+
+// FlowDataSourceModel defines the Terraform data source model.
+type FlowDataSourceModel struct {
+	ID          customtypes.UUIDValue      `tfsdk:"id"`
+	Created     customtypes.TimestampValue `tfsdk:"created"`
+	Updated     customtypes.TimestampValue `tfsdk:"updated"`
+	AccountID   customtypes.UUIDValue      `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue      `tfsdk:"workspace_id"`
+
+	Name types.String `tfsdk:"name"`
+	Tags types.List   `tfsdk:"tags"`
+}
+
+// NewFlowDataSource returns a new FlowDataSource.
+//
+//nolint:ireturn // required by Terraform API
+func NewFlowDataSource() datasource.DataSource {
+	return &FlowDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *FlowDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_flow"
+}
+
+// Configure initializes runtime state for the data source.
+func (d *FlowDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*helpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("data source", req.ProviderData))
+
+		return
+	}
+
+	d.client = data.Client
+}
+
+var flowAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Computed:    true,
+		CustomType:  customtypes.UUIDType{},
+		Description: "Flow ID (UUID)",
+	},
+	"created": schema.StringAttribute{
+		Computed:    true,
+		CustomType:  customtypes.TimestampType{},
+		Description: "Timestamp of when the resource was created (RFC3339)",
+	},
+	"updated": schema.StringAttribute{
+		Computed:    true,
+		CustomType:  customtypes.TimestampType{},
+		Description: "Timestamp of when the resource was updated (RFC3339)",
+	},
+	"account_id": schema.StringAttribute{
+		CustomType:  customtypes.UUIDType{},
+		Description: "Account ID (UUID), defaults to the account set in the provider",
+		Optional:    true,
+	},
+	"workspace_id": schema.StringAttribute{
+		CustomType:  customtypes.UUIDType{},
+		Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+		Optional:    true,
+	},
+	"name": schema.StringAttribute{
+		Description: "Name of the flow",
+		Required:    true,
+	},
+	"tags": schema.ListAttribute{
+		Computed:    true,
+		Description: "Tags associated with the flow",
+		ElementType: types.StringType,
+	},
+}
+
+// Schema defines the schema for the data source.
+func (d *FlowDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Get information about an existing Flow by name.
+<br>
+Use this data source to obtain the Flow ID, e.g. for the ` + "`flow_id`" + ` of a Deployment.
+`,
+		Attributes: flowAttributes,
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *FlowDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model FlowDataSourceModel
+
+	// Populate the model from data source configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.Flows(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Flow", err))
+
+		return
+	}
+
+	flow, err := client.GetByName(ctx, model.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Flow", "get", err))
+
+		return
+	}
+
+	model.ID = customtypes.NewUUIDValue(flow.ID)
+	model.Created = customtypes.NewTimestampPointerValue(flow.Created)
+	model.Updated = customtypes.NewTimestampPointerValue(flow.Updated)
+
+	model.Name = types.StringValue(flow.Name)
+
+	list, diags := types.ListValueFrom(ctx, types.StringType, flow.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	model.Tags = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
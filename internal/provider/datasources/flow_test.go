@@ -0,0 +1,51 @@
+package datasources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccFlowByName(workspace, workspaceName, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "prefect_flow" "%s" {
+	name = "%s"
+	tags = ["test"]
+	workspace_id = prefect_workspace.%s.id
+}
+
+data "prefect_flow" "test" {
+	name = prefect_flow.%s.name
+	workspace_id = prefect_workspace.%s.id
+}
+`, workspace, name, name, workspaceName, name, workspaceName)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccDatasource_flow(t *testing.T) {
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+	randomName := testutils.NewRandomPrefixedString()
+	datasourceName := "data.prefect_flow.test"
+	resourceName := "prefect_flow." + randomName
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccFlowByName(workspace, workspaceName, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttr(datasourceName, "name", randomName),
+					resource.TestCheckResourceAttr(datasourceName, "tags.#", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "tags.0", "test"),
+					resource.TestCheckResourceAttrSet(datasourceName, "created"),
+				),
+			},
+		},
+	})
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
@@ -17,7 +18,8 @@ var _ = datasource.DataSourceWithConfigure(&ServiceAccountDataSource{})
 
 // ServiceAccountDataSource contains state for the data source.
 type ServiceAccountDataSource struct {
-	client api.PrefectClient
+	client   api.PrefectClient
+	settings helpers.ProviderSettings
 }
 
 // ServiceAccountDataSourceModel defines the Terraform data source model.
@@ -57,14 +59,15 @@ func (d *ServiceAccountDataSource) Configure(_ context.Context, req datasource.C
 		return
 	}
 
-	client, ok := req.ProviderData.(api.PrefectClient)
+	data, ok := req.ProviderData.(*helpers.ProviderData)
 	if !ok {
 		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("data source", req.ProviderData))
 
 		return
 	}
 
-	d.client = client
+	d.client = data.Client
+	d.settings = data.Settings
 }
 
 var serviceAccountAttributes = map[string]schema.Attribute{
@@ -217,6 +220,13 @@ func (d *ServiceAccountDataSource) Read(ctx context.Context, req datasource.Read
 	model.APIKeyExpires = customtypes.NewTimestampPointerValue(serviceAccount.APIKey.Expiration)
 	model.APIKey = types.StringValue(serviceAccount.APIKey.Key)
 
+	// Data sources are read at plan time, so this warns ahead of the key expiring.
+	resp.Diagnostics.Append(helpers.APIKeyExpirationWarningDiagnostics(
+		path.Root("api_key_expiration"),
+		serviceAccount.APIKey.Expiration,
+		d.settings.APIKeyExpirationWarningDays,
+	)...)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -0,0 +1,203 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = datasource.DataSourceWithConfigure(&VariablesDataSource{})
+
+// VariablesDataSource contains state for the data source.
+type VariablesDataSource struct {
+	client api.PrefectClient
+}
+
+// VariablesDataSourceModel defines the Terraform data source model.
+type VariablesDataSourceModel struct {
+	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+
+	Variables types.List `tfsdk:"variables"`
+	Values    types.Map  `tfsdk:"values"`
+}
+
+// NewVariablesDataSource returns a new VariablesDataSource.
+//
+//nolint:ireturn // required by Terraform API
+func NewVariablesDataSource() datasource.DataSource {
+	return &VariablesDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *VariablesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_variables"
+}
+
+// Configure initializes runtime state for the data source.
+func (d *VariablesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*helpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("data source", req.ProviderData))
+
+		return
+	}
+
+	d.client = data.Client
+}
+
+// Schema defines the schema for the data source.
+func (d *VariablesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Get information about all Variables in a Workspace.
+<br>
+Use this data source to read the Variables of one Workspace, for example to copy them into another Workspace
+by passing ` + "`values`" + ` to a ` + "`prefect_variables`" + ` resource.
+`,
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"variables": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Variables returned by the server",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.UUIDType{},
+							Description: "Variable ID (UUID)",
+						},
+						"created": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.TimestampType{},
+							Description: "Timestamp of when the resource was created (RFC3339)",
+						},
+						"updated": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.TimestampType{},
+							Description: "Timestamp of when the resource was updated (RFC3339)",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the variable",
+						},
+						"value": schema.StringAttribute{
+							Computed:    true,
+							Description: "Value of the variable",
+						},
+						"tags": schema.ListAttribute{
+							Computed:    true,
+							Description: "Tags associated with the variable",
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+			"values": schema.MapAttribute{
+				Computed:    true,
+				Description: "Map of variable names to JSON-encoded values, in the format of the `prefect_variables` resource",
+				ElementType: jsontypes.NormalizedType{},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *VariablesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model VariablesDataSourceModel
+
+	// Populate the model from data source configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.Variables(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Variable", err))
+
+		return
+	}
+
+	variables, err := client.List(ctx, api.VariableFilter{})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Variables", "list", err))
+
+		return
+	}
+
+	attributeTypes := map[string]attr.Type{
+		"id":      customtypes.UUIDType{},
+		"created": customtypes.TimestampType{},
+		"updated": customtypes.TimestampType{},
+		"name":    types.StringType,
+		"value":   types.StringType,
+		"tags":    types.ListType{ElemType: types.StringType},
+	}
+
+	variableObjects := make([]attr.Value, 0, len(variables))
+	values := make(map[string]string, len(variables))
+	for _, variable := range variables {
+		tags, diags := types.ListValueFrom(ctx, types.StringType, variable.Tags)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		variableObject, diags := types.ObjectValue(attributeTypes, map[string]attr.Value{
+			"id":      customtypes.NewUUIDValue(variable.ID),
+			"created": customtypes.NewTimestampPointerValue(variable.Created),
+			"updated": customtypes.NewTimestampPointerValue(variable.Updated),
+			"name":    types.StringValue(variable.Name),
+			"value":   types.StringValue(variable.Value),
+			"tags":    tags,
+		})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		variableObjects = append(variableObjects, variableObject)
+		values[variable.Name] = string(variable.RawValue)
+	}
+
+	list, diags := types.ListValue(types.ObjectType{AttrTypes: attributeTypes}, variableObjects)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	model.Variables = list
+
+	valuesMap, diags := types.MapValueFrom(ctx, jsontypes.NormalizedType{}, values)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	model.Values = valuesMap
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,74 @@
+package datasources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccVariablesCopy(sourceWorkspace, sourceName, targetWorkspace, targetName, name, value string) string {
+	return fmt.Sprintf(`
+%s
+
+%s
+
+resource "prefect_variable" "source" {
+	name = "%s"
+	value = "%s"
+	workspace_id = prefect_workspace.%s.id
+}
+
+data "prefect_variables" "source" {
+	workspace_id = prefect_workspace.%s.id
+	depends_on = [prefect_variable.source]
+}
+
+resource "prefect_variables" "target" {
+	workspace_id = prefect_workspace.%s.id
+	variables = data.prefect_variables.source.values
+}
+
+data "prefect_variable" "target" {
+	name = "%s"
+	workspace_id = prefect_workspace.%s.id
+	depends_on = [prefect_variables.target]
+}
+`, sourceWorkspace, targetWorkspace, name, value, sourceName, sourceName, targetName, name, targetName)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccDatasource_variables_copy(t *testing.T) {
+	sourceWorkspace, sourceName := testutils.NewEphemeralWorkspace()
+	targetWorkspace, targetName := testutils.NewEphemeralWorkspace()
+	variableName := testutils.NewRandomPrefixedString()
+	variableValue := "copied value"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccVariablesCopy(sourceWorkspace, sourceName, targetWorkspace, targetName, variableName, variableValue),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.prefect_variables.source", "variables.#", "1"),
+					resource.TestCheckResourceAttr("data.prefect_variables.source", "variables.0.name", variableName),
+					resource.TestCheckResourceAttr("data.prefect_variables.source", "values."+variableName, fmt.Sprintf("%q", variableValue)),
+					resource.TestCheckResourceAttr("data.prefect_variable.target", "value", variableValue),
+					resource.TestCheckResourceAttrPair("data.prefect_variable.target", "workspace_id", "prefect_workspace."+targetName, "id"),
+					func(s *terraform.State) error {
+						sourceID := s.RootModule().Resources["prefect_variable.source"].Primary.ID
+						targetID := s.RootModule().Resources["data.prefect_variable.target"].Primary.ID
+						if sourceID == targetID {
+							return fmt.Errorf("expected the copied variable to be a new variable, got the source variable %s", sourceID)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -31,13 +32,15 @@ type WorkPoolDataSourceModel struct {
 	AccountID   customtypes.UUIDValue      `tfsdk:"account_id"`
 	WorkspaceID customtypes.UUIDValue      `tfsdk:"workspace_id"`
 
-	Name             types.String          `tfsdk:"name"`
-	Description      types.String          `tfsdk:"description"`
-	Type             types.String          `tfsdk:"type"`
-	Paused           types.Bool            `tfsdk:"paused"`
-	ConcurrencyLimit types.Int64           `tfsdk:"concurrency_limit"`
-	DefaultQueueID   customtypes.UUIDValue `tfsdk:"default_queue_id"`
-	BaseJobTemplate  types.String          `tfsdk:"base_job_template"`
+	Name               types.String          `tfsdk:"name"`
+	Description        types.String          `tfsdk:"description"`
+	Type               types.String          `tfsdk:"type"`
+	Paused             types.Bool            `tfsdk:"paused"`
+	ConcurrencyLimit   types.Int64           `tfsdk:"concurrency_limit"`
+	DefaultQueueID     customtypes.UUIDValue `tfsdk:"default_queue_id"`
+	BaseJobTemplate    types.String          `tfsdk:"base_job_template"`
+	JobVariablesSchema jsontypes.Normalized  `tfsdk:"job_variables_schema"`
+	OnlineWorkerCount  types.Int64           `tfsdk:"online_worker_count"`
 }
 
 // NewWorkPoolDataSource returns a new WorkPoolDataSource.
@@ -58,14 +61,14 @@ func (d *WorkPoolDataSource) Configure(_ context.Context, req datasource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(api.PrefectClient)
+	data, ok := req.ProviderData.(*helpers.ProviderData)
 	if !ok {
 		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("data source", req.ProviderData))
 
 		return
 	}
 
-	d.client = client
+	d.client = data.Client
 }
 
 // Shared set of schema attributes between work_pool (singular)
@@ -121,6 +124,11 @@ var workPoolAttributesBase = map[string]schema.Attribute{
 		Computed:    true,
 		Description: "The base job template for the work pool, as a JSON string",
 	},
+	"job_variables_schema": schema.StringAttribute{
+		Computed:    true,
+		CustomType:  jsontypes.NormalizedType{},
+		Description: "The JSON schema of the job variables that deployments in this work pool can set (the `variables` section of the base job template), as a JSON string",
+	},
 }
 
 // Schema defines the schema for the data source.
@@ -142,6 +150,10 @@ func (d *WorkPoolDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 		Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
 		Optional:    true,
 	}
+	workPoolAttributes["online_worker_count"] = schema.Int64Attribute{
+		Computed:    true,
+		Description: "Number of workers currently polling the work pool, as reported by the server when the data source is read",
+	}
 
 	resp.Schema = schema.Schema{
 		Description: `
@@ -187,6 +199,29 @@ func (d *WorkPoolDataSource) Read(ctx context.Context, req datasource.ReadReques
 	model.ConcurrencyLimit = types.Int64PointerValue(pool.ConcurrencyLimit)
 	model.DefaultQueueID = customtypes.NewUUIDValue(pool.DefaultQueueID)
 
+	workers, err := client.ListWorkers(ctx, pool.Name)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Work Pool workers", "list", err))
+
+		return
+	}
+
+	var onlineWorkers int64
+	for _, worker := range workers {
+		if worker.Status == api.WorkerStatusOnline {
+			onlineWorkers++
+		}
+	}
+	model.OnlineWorkerCount = types.Int64Value(onlineWorkers)
+
+	jobVariablesSchema, err := helpers.JobVariablesSchema(pool.BaseJobTemplate)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.SerializeDataErrorDiagnostic("job_variables_schema", "Work Pool job variables schema", err))
+
+		return
+	}
+	model.JobVariablesSchema = jobVariablesSchema
+
 	if pool.BaseJobTemplate != nil {
 		var builder strings.Builder
 		encoder := json.NewEncoder(&builder)
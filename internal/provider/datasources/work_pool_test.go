@@ -1,6 +1,10 @@
 package datasources_test
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -49,7 +53,9 @@ func TestAccDatasource_work_pool(t *testing.T) {
 					resource.TestCheckResourceAttrSet(singleWorkPoolDatasourceName, "type"),
 					resource.TestCheckResourceAttrSet(singleWorkPoolDatasourceName, "paused"),
 					resource.TestCheckResourceAttrSet(singleWorkPoolDatasourceName, "default_queue_id"),
+					resource.TestCheckResourceAttrSet(singleWorkPoolDatasourceName, "online_worker_count"),
 					resource.TestCheckResourceAttrSet(singleWorkPoolDatasourceName, "base_job_template"),
+					resource.TestCheckResourceAttrSet(singleWorkPoolDatasourceName, "job_variables_schema"),
 				),
 			},
 			{
@@ -64,6 +70,60 @@ func TestAccDatasource_work_pool(t *testing.T) {
 					resource.TestCheckResourceAttrSet(multipleWorkPoolDatasourceName, "work_pools.0.paused"),
 					resource.TestCheckResourceAttrSet(multipleWorkPoolDatasourceName, "work_pools.0.default_queue_id"),
 					resource.TestCheckResourceAttrSet(multipleWorkPoolDatasourceName, "work_pools.0.base_job_template"),
+					resource.TestCheckResourceAttrSet(multipleWorkPoolDatasourceName, "work_pools.0.job_variables_schema"),
+				),
+			},
+		},
+	})
+}
+
+func fixtureAccWorkPoolWorkers(endpoint string) string {
+	return fmt.Sprintf(`
+provider "prefect" {
+	endpoint = "%s"
+}
+
+data "prefect_work_pool" "mock" {
+	name = "mock-pool"
+}
+`, endpoint)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccDatasource_work_pool_online_workers(t *testing.T) {
+	// The server reports a pool with two online workers and one offline worker.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/work_pools/mock-pool"):
+			_, _ = w.Write([]byte(`{
+				"id": "2b7a7b6e-4c1f-4f5e-9f6b-1c2d3e4f5a6b",
+				"name": "mock-pool",
+				"type": "process",
+				"is_paused": false,
+				"default_queue_id": "3c8b8c7f-5d2a-4a6f-8a7c-2d3e4f5a6b7c",
+				"base_job_template": {}
+			}`))
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/work_pools/mock-pool/workers/filter"):
+			_, _ = w.Write([]byte(`[
+				{"id": "4d9c9d8a-6e3b-4b7a-9b8d-3e4f5a6b7c8d", "name": "worker-1", "status": "ONLINE"},
+				{"id": "5e0d0e9b-7f4c-4c8b-8c9e-4f5a6b7c8d9e", "name": "worker-2", "status": "ONLINE"},
+				{"id": "6f1e1f0c-8a5d-4d9c-9d0f-5a6b7c8d9e0f", "name": "worker-3", "status": "OFFLINE"}
+			]`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccWorkPoolWorkers(server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.prefect_work_pool.mock", "name", "mock-pool"),
+					resource.TestCheckResourceAttr("data.prefect_work_pool.mock", "online_worker_count", "2"),
 				),
 			},
 		},
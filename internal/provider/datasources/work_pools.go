@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -51,14 +52,14 @@ func (d *WorkPoolsDataSource) Configure(_ context.Context, req datasource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(api.PrefectClient)
+	data, ok := req.ProviderData.(*helpers.ProviderData)
 	if !ok {
 		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("data source", req.ProviderData))
 
 		return
 	}
 
-	d.client = client
+	d.client = data.Client
 }
 
 // Schema defines the schema for the data source.
@@ -123,16 +124,17 @@ func (d *WorkPoolsDataSource) Read(ctx context.Context, req datasource.ReadReque
 	}
 
 	attributeTypes := map[string]attr.Type{
-		"id":                customtypes.UUIDType{},
-		"created":           customtypes.TimestampType{},
-		"updated":           customtypes.TimestampType{},
-		"name":              types.StringType,
-		"description":       types.StringType,
-		"type":              types.StringType,
-		"paused":            types.BoolType,
-		"concurrency_limit": types.Int64Type,
-		"default_queue_id":  customtypes.UUIDType{},
-		"base_job_template": types.StringType,
+		"id":                   customtypes.UUIDType{},
+		"created":              customtypes.TimestampType{},
+		"updated":              customtypes.TimestampType{},
+		"name":                 types.StringType,
+		"description":          types.StringType,
+		"type":                 types.StringType,
+		"paused":               types.BoolType,
+		"concurrency_limit":    types.Int64Type,
+		"default_queue_id":     customtypes.UUIDType{},
+		"base_job_template":    types.StringType,
+		"job_variables_schema": jsontypes.NormalizedType{},
 	}
 
 	poolObjects := make([]attr.Value, 0, len(pools))
@@ -149,6 +151,14 @@ func (d *WorkPoolsDataSource) Read(ctx context.Context, req datasource.ReadReque
 			"default_queue_id":  customtypes.NewUUIDValue(pool.DefaultQueueID),
 		}
 
+		jobVariablesSchema, err := helpers.JobVariablesSchema(pool.BaseJobTemplate)
+		if err != nil {
+			resp.Diagnostics.Append(helpers.SerializeDataErrorDiagnostic("job_variables_schema", "Work Pool job variables schema", err))
+
+			return
+		}
+		attributeValues["job_variables_schema"] = jobVariablesSchema
+
 		if pool.BaseJobTemplate == nil {
 			attributeValues["base_job_template"] = types.StringNull()
 		} else {
@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
@@ -51,14 +52,14 @@ func (d *WorkspaceDataSource) Configure(_ context.Context, req datasource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(api.PrefectClient)
+	data, ok := req.ProviderData.(*helpers.ProviderData)
 	if !ok {
 		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("data source", req.ProviderData))
 
 		return
 	}
 
-	d.client = client
+	d.client = data.Client
 }
 
 var workspaceAttributes = map[string]schema.Attribute{
@@ -157,10 +158,16 @@ func (d *WorkspaceDataSource) Read(ctx context.Context, req datasource.ReadReque
 		operation = "list"
 		workspaces, err = client.List(ctx, []string{model.Handle.ValueString()})
 
-		// The error from the API call should take precedence
-		// followed by this custom error if a specific workspace is not returned
+		// The error from the API call takes precedence over
+		// a specific workspace not being returned
 		if err == nil && len(workspaces) != 1 {
-			err = fmt.Errorf("a workspace with the handle=%s could not be found", model.Handle.ValueString())
+			resp.Diagnostics.AddAttributeError(
+				path.Root("handle"),
+				"Workspace not found",
+				fmt.Sprintf("Could not find a workspace with the handle %q in the account.", model.Handle.ValueString()),
+			)
+
+			return
 		}
 
 		if len(workspaces) == 1 {
@@ -168,6 +175,12 @@ func (d *WorkspaceDataSource) Read(ctx context.Context, req datasource.ReadReque
 		}
 	}
 
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Workspace", operation, err))
+
+		return
+	}
+
 	if workspace == nil {
 		resp.Diagnostics.AddError(
 			"Error refreshing workspace state",
@@ -177,12 +190,6 @@ func (d *WorkspaceDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	if err != nil {
-		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Workspace", operation, err))
-
-		return
-	}
-
 	model.ID = customtypes.NewUUIDValue(workspace.ID)
 	model.Created = customtypes.NewTimestampPointerValue(workspace.Created)
 	model.Updated = customtypes.NewTimestampPointerValue(workspace.Updated)
@@ -2,6 +2,7 @@ package datasources_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -15,6 +16,7 @@ data "prefect_workspace" "evergreen" {
 }
 `, handle)
 }
+
 func fixtureAccWorkspaceByID(id string) string {
 	return fmt.Sprintf(`
 data "prefect_workspace" "evergreen" {
@@ -56,3 +58,17 @@ func TestAccDatasource_workspace(t *testing.T) {
 		},
 	})
 }
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccDatasource_workspace_handle_not_found(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      fixtureAccWorkspaceByHandle(testutils.NewRandomPrefixedString()),
+				ExpectError: regexp.MustCompile(`Workspace not found`),
+			},
+		},
+	})
+}
@@ -0,0 +1,25 @@
+package helpers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+// JSONChecksum returns a SHA-256 checksum of the canonical JSON encoding
+// of value, so that objects differing only in key order (or whitespace)
+// produce the same checksum.
+//
+// Object keys are sorted when encoding maps, which gives us the canonical form,
+// as long as value was decoded into generic maps rather than structs.
+func JSONChecksum(value interface{}) (string, error) {
+	byteSlice, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize value for checksum: %w", err)
+	}
+
+	sum := sha256.Sum256(byteSlice)
+
+	return hex.EncodeToString(sum[:]), nil
+}
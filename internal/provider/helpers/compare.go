@@ -1,6 +1,13 @@
 package helpers
 
-import "github.com/go-test/deep"
+import (
+	"strings"
+
+	"github.com/go-test/deep"
+)
+
+// MaskedValue is the placeholder the API returns in place of secret values.
+const MaskedValue = "********"
 
 // ObjectsEqual checks to see if two objects are equivalent, accounting for
 // differences in the order of the contents.
@@ -12,3 +19,158 @@ func ObjectsEqual(obj1, obj2 interface{}) (bool, []string) {
 
 	return true, nil
 }
+
+// RestoreMaskedValues returns a copy of actual where every value the API
+// masked is replaced by the value found at the same location in expected.
+//
+// This lets us compare a payload we sent with what the API returns,
+// without secret values (which are always masked on read) showing up
+// as differences.
+func RestoreMaskedValues(expected, actual interface{}) interface{} {
+	return restoreMaskedValues(expected, actual, "", nil)
+}
+
+// RestoreMaskedSecretValues is like RestoreMaskedValues, but only restores
+// values at the given secret field paths, e.g. as listed in a block
+// schema's `secret_fields`. If secretFields is nil, every masked value
+// is restored.
+func RestoreMaskedSecretValues(expected, actual interface{}, secretFields []string) interface{} {
+	return restoreMaskedValues(expected, actual, "", secretFields)
+}
+
+func restoreMaskedValues(expected, actual interface{}, path string, secretFields []string) interface{} {
+	switch typedActual := actual.(type) {
+	case string:
+		if typedActual == MaskedValue && expected != nil && isSecretField(path, secretFields) {
+			return expected
+		}
+
+		return typedActual
+	case map[string]interface{}:
+		typedExpected, _ := expected.(map[string]interface{})
+
+		restored := make(map[string]interface{}, len(typedActual))
+		for key, value := range typedActual {
+			restored[key] = restoreMaskedValues(typedExpected[key], value, joinFieldPath(path, key), secretFields)
+		}
+
+		return restored
+	case []interface{}:
+		typedExpected, _ := expected.([]interface{})
+
+		restored := make([]interface{}, len(typedActual))
+		for i, value := range typedActual {
+			var expectedValue interface{}
+			if i < len(typedExpected) {
+				expectedValue = typedExpected[i]
+			}
+			restored[i] = restoreMaskedValues(expectedValue, value, path, secretFields)
+		}
+
+		return restored
+	default:
+		return actual
+	}
+}
+
+// MaskValues is the inverse of RestoreMaskedValues: it returns a copy of
+// actual where every value masked in template is masked again.
+//
+// This lets a user keep a placeholder for a secret in their configuration,
+// without the real value read from the API showing up as drift.
+func MaskValues(template, actual interface{}) interface{} {
+	return maskValues(template, actual, "", nil)
+}
+
+// MaskSecretValues is like MaskValues, but only masks values at the given
+// secret field paths, so a non-secret value that happens to equal
+// MaskedValue is compared as-is. If secretFields is nil, every value
+// masked in template is masked again.
+func MaskSecretValues(template, actual interface{}, secretFields []string) interface{} {
+	return maskValues(template, actual, "", secretFields)
+}
+
+func maskValues(template, actual interface{}, path string, secretFields []string) interface{} {
+	switch typedTemplate := template.(type) {
+	case string:
+		if typedTemplate == MaskedValue && actual != nil && isSecretField(path, secretFields) {
+			return MaskedValue
+		}
+
+		return actual
+	case map[string]interface{}:
+		typedActual, ok := actual.(map[string]interface{})
+		if !ok {
+			return actual
+		}
+
+		masked := make(map[string]interface{}, len(typedActual))
+		for key, value := range typedActual {
+			masked[key] = maskValues(typedTemplate[key], value, joinFieldPath(path, key), secretFields)
+		}
+
+		return masked
+	case []interface{}:
+		typedActual, ok := actual.([]interface{})
+		if !ok {
+			return actual
+		}
+
+		masked := make([]interface{}, len(typedActual))
+		for i, value := range typedActual {
+			var templateValue interface{}
+			if i < len(typedTemplate) {
+				templateValue = typedTemplate[i]
+			}
+			masked[i] = maskValues(templateValue, value, path, secretFields)
+		}
+
+		return masked
+	default:
+		return actual
+	}
+}
+
+// isSecretField reports whether the dotted field path is one of the
+// secret fields. A `*` segment matches any key, and a trailing `*`
+// matches everything nested below it, as for Prefect's SecretDict.
+func isSecretField(path string, secretFields []string) bool {
+	if secretFields == nil {
+		return true
+	}
+
+	segments := strings.Split(path, ".")
+	for _, secretField := range secretFields {
+		if matchFieldPath(segments, strings.Split(secretField, ".")) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchFieldPath(segments, pattern []string) bool {
+	for i, patternSegment := range pattern {
+		if i >= len(segments) {
+			return false
+		}
+
+		if patternSegment == "*" && i == len(pattern)-1 {
+			return true
+		}
+
+		if patternSegment != "*" && patternSegment != segments[i] {
+			return false
+		}
+	}
+
+	return len(segments) == len(pattern)
+}
+
+func joinFieldPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
@@ -2,6 +2,8 @@ package helpers
 
 import (
 	"fmt"
+	"math"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -34,13 +36,13 @@ func ResourceClientErrorDiagnostic(resourceName string, operation string, err er
 }
 
 // ConfigureTypeErrorDiagnostic returns an error diagnostic for when a
-// given type does not implement PrefectClient.
+// given type is not *ProviderData.
 //
 //nolint:ireturn // required by Terraform API
 func ConfigureTypeErrorDiagnostic(componentKind string, data any) diag.Diagnostic {
 	return diag.NewErrorDiagnostic(
 		fmt.Sprintf("Unexpected %s Configure type", componentKind),
-		fmt.Sprintf("Expected api.PrefectClient type, got %T. %s", data, reportMessage),
+		fmt.Sprintf("Expected *helpers.ProviderData type, got %T. %s", data, reportMessage),
 	)
 }
 
@@ -67,3 +69,39 @@ func ParseUUIDErrorDiagnostic(resourceName string, err error) diag.Diagnostic {
 		fmt.Sprintf("Could not parse %s ID to UUID, unexpected error: %s", resourceName, err.Error()),
 	)
 }
+
+// APIKeyExpirationWarningDiagnostics returns a warning diagnostic when the
+// API Key expiration is within the given number of days, so the key can
+// be rotated ahead of time. No warning is returned if days is 0.
+func APIKeyExpirationWarningDiagnostics(attributePath path.Path, expiration *time.Time, days int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if expiration == nil || days <= 0 {
+		return diags
+	}
+
+	remaining := time.Until(*expiration)
+	if remaining > time.Duration(days)*24*time.Hour {
+		return diags
+	}
+
+	if remaining <= 0 {
+		diags.AddAttributeWarning(
+			attributePath,
+			"API Key expired",
+			fmt.Sprintf("The API Key expired at %s. Rotate the key, e.g. by updating `api_key_expiration`.", expiration.Format(time.RFC3339)),
+		)
+
+		return diags
+	}
+
+	diags.AddAttributeWarning(
+		attributePath,
+		"API Key expires soon",
+		fmt.Sprintf("The API Key expires at %s, in %d day(s), which is within the provider's api_key_expiration_warning_days of %d. "+
+			"Rotate the key ahead of time, e.g. by updating `api_key_expiration`.",
+			expiration.Format(time.RFC3339), int64(math.Ceil(remaining.Hours()/24)), days),
+	)
+
+	return diags
+}
@@ -0,0 +1,120 @@
+package helpers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// DynamicToJSON serializes a dynamic value, e.g. an HCL object, to a JSON string.
+// The bool result is false if the value isn't fully known yet, in which case
+// the JSON string is empty.
+func DynamicToJSON(ctx context.Context, value types.Dynamic) (string, bool, error) {
+	tfValue, err := value.ToTerraformValue(ctx)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to convert value: %w", err)
+	}
+
+	if !tfValue.IsFullyKnown() {
+		return "", false, nil
+	}
+
+	if tfValue.IsNull() {
+		return "null", true, nil
+	}
+
+	converted, err := terraformValueToInterface(tfValue)
+	if err != nil {
+		return "", false, err
+	}
+
+	byteSlice, err := json.Marshal(converted)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to serialize value: %w", err)
+	}
+
+	return string(byteSlice), true, nil
+}
+
+// terraformValueToInterface converts a known, non-null Terraform value to
+// the equivalent value decoded from JSON, e.g. objects to maps.
+func terraformValueToInterface(value tftypes.Value) (interface{}, error) {
+	valueType := value.Type()
+
+	switch {
+	case valueType.Is(tftypes.String):
+		var converted string
+		err := value.As(&converted)
+
+		return converted, err
+	case valueType.Is(tftypes.Bool):
+		var converted bool
+		err := value.As(&converted)
+
+		return converted, err
+	case valueType.Is(tftypes.Number):
+		var number big.Float
+		if err := value.As(&number); err != nil {
+			return nil, err
+		}
+
+		if number.IsInt() {
+			if converted, accuracy := number.Int64(); accuracy == big.Exact {
+				return converted, nil
+			}
+		}
+		converted, _ := number.Float64()
+
+		return converted, nil
+	case valueType.Is(tftypes.List{}), valueType.Is(tftypes.Set{}), valueType.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+
+		converted := make([]interface{}, 0, len(elements))
+		for _, element := range elements {
+			if element.IsNull() {
+				converted = append(converted, nil)
+
+				continue
+			}
+
+			convertedElement, err := terraformValueToInterface(element)
+			if err != nil {
+				return nil, err
+			}
+			converted = append(converted, convertedElement)
+		}
+
+		return converted, nil
+	case valueType.Is(tftypes.Map{}), valueType.Is(tftypes.Object{}):
+		var attributes map[string]tftypes.Value
+		if err := value.As(&attributes); err != nil {
+			return nil, err
+		}
+
+		converted := make(map[string]interface{}, len(attributes))
+		for key, attribute := range attributes {
+			if attribute.IsNull() {
+				converted[key] = nil
+
+				continue
+			}
+
+			convertedAttribute, err := terraformValueToInterface(attribute)
+			if err != nil {
+				return nil, err
+			}
+			converted[key] = convertedAttribute
+		}
+
+		return converted, nil
+	default:
+		return nil, fmt.Errorf("unsupported value type %s", valueType)
+	}
+}
@@ -0,0 +1,42 @@
+package helpers
+
+import (
+	"regexp"
+	"strings"
+)
+
+// entrypointFunctionRegexp matches the flow function of an entrypoint, which
+// can be a method, e.g. `Pipeline.run`.
+var entrypointFunctionRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*$`)
+
+// ValidateEntrypoint checks that a deployment entrypoint has a format
+// Prefect can load a flow from: the path to a file and the flow function
+// in it, separated by a colon, e.g. `flows/etl.py:etl`, or the dotted path
+// to the function in a module, e.g. `flows.etl.etl`.
+//
+// It returns why the entrypoint is invalid, or an empty string if it's valid.
+// Whether the file or module exists is left to the worker.
+func ValidateEntrypoint(entrypoint string) string {
+	if strings.TrimSpace(entrypoint) == "" {
+		return "must not be empty"
+	}
+
+	// The last colon separates the function, as Windows paths can have one.
+	if index := strings.LastIndex(entrypoint, ":"); index >= 0 {
+		filePath, function := entrypoint[:index], entrypoint[index+1:]
+		if filePath == "" {
+			return "must include the path to the file defining the flow, e.g. `flows/etl.py:etl`"
+		}
+		if !entrypointFunctionRegexp.MatchString(function) {
+			return "must end with the name of the flow function after the colon, e.g. `flows/etl.py:etl`"
+		}
+
+		return ""
+	}
+
+	if !strings.Contains(entrypoint, ".") || !entrypointFunctionRegexp.MatchString(entrypoint) {
+		return "must be a file path and flow function separated by a colon, e.g. `flows/etl.py:etl`, or a dotted module path to the flow function, e.g. `flows.etl.etl`"
+	}
+
+	return ""
+}
@@ -0,0 +1,54 @@
+package helpers
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+)
+
+// JobVariablesSchema returns the `variables` JSON schema of a work pool's
+// base job template, which describes the job variables a deployment can set.
+// The result is null if the template has no variables schema.
+func JobVariablesSchema(baseJobTemplate map[string]interface{}) (jsontypes.Normalized, error) {
+	variables, ok := baseJobTemplate["variables"]
+	if !ok || variables == nil {
+		return jsontypes.NewNormalizedNull(), nil
+	}
+
+	byteSlice, err := json.Marshal(variables)
+	if err != nil {
+		return jsontypes.NewNormalizedNull(), fmt.Errorf("failed to serialize job variables schema: %w", err)
+	}
+
+	return jsontypes.NewNormalizedValue(string(byteSlice)), nil
+}
+
+// baseJobTemplateSections are the top-level keys that a base job template
+// must define for workers to be able to submit flow runs.
+var baseJobTemplateSections = []string{"job_configuration", "variables"}
+
+// ValidateBaseJobTemplate checks that a base job template defines the
+// `job_configuration` and `variables` sections as objects. An empty
+// template is valid, as the server then uses the default template of
+// the work pool type.
+//
+// The error names the first key that is missing or invalid.
+func ValidateBaseJobTemplate(baseJobTemplate map[string]interface{}) error {
+	if len(baseJobTemplate) == 0 {
+		return nil
+	}
+
+	for _, section := range baseJobTemplateSections {
+		value, ok := baseJobTemplate[section]
+		if !ok {
+			return fmt.Errorf("the base job template is missing the required `%s` key", section)
+		}
+
+		if _, ok := value.(map[string]interface{}); !ok {
+			return fmt.Errorf("the `%s` key of the base job template must be an object, got %s", section, jsonTypeName(value))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package helpers
+
+// MergeObjects returns a copy of base with overrides deep-merged on top.
+//
+// Nested objects are merged key by key, so an override only needs to
+// contain the values that differ from base. Any other value (including
+// lists) in overrides replaces the corresponding value in base.
+func MergeObjects(base, overrides map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(base))
+	for key, value := range base {
+		merged[key] = value
+	}
+
+	for key, overrideValue := range overrides {
+		overrideObject, overrideIsObject := overrideValue.(map[string]interface{})
+		baseObject, baseIsObject := merged[key].(map[string]interface{})
+
+		if overrideIsObject && baseIsObject {
+			merged[key] = MergeObjects(baseObject, overrideObject)
+
+			continue
+		}
+
+		merged[key] = overrideValue
+	}
+
+	return merged
+}
@@ -0,0 +1,296 @@
+package helpers
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// maxParameterSchemaDepth bounds how deeply schemas are resolved and
+// validated, so recursive definitions can't loop forever.
+const maxParameterSchemaDepth = 32
+
+// ParameterSchemaViolation describes a parameter that doesn't match a
+// deployment's parameter schema.
+type ParameterSchemaViolation struct {
+	// Path is the dotted path to the parameter, e.g. `parameters.config.region`.
+	Path    string
+	Message string
+}
+
+// ValidateParameters checks deployment parameters against a parameter schema,
+// as generated by Prefect from a flow's signature.
+//
+// Required parameters and types are checked, recursing into nested objects
+// and into the elements of arrays (with `items`, or `prefixItems` for tuples),
+// including ones defined under `definitions` (or `$defs`) and referenced
+// with `$ref`. Parameters that aren't in the schema are reported too, since
+// a flow can't be called with arguments missing from its signature, as are
+// nested keys of objects with `additionalProperties` set to false. Other
+// schema keywords are left to the API.
+func ValidateParameters(parameterSchema, parameters map[string]interface{}) []ParameterSchemaViolation {
+	v := parameterValidator{definitions: parameterSchemaDefinitions(parameterSchema)}
+	v.validateObject("parameters", parameterSchema, parameters, 0)
+
+	return v.violations
+}
+
+// parameterSchemaDefinitions returns the definitions a parameter schema's
+// `$ref`s can point to, from `definitions` or `$defs`.
+func parameterSchemaDefinitions(parameterSchema map[string]interface{}) map[string]interface{} {
+	definitions := map[string]interface{}{}
+	for _, key := range []string{"definitions", "$defs"} {
+		if defs, ok := parameterSchema[key].(map[string]interface{}); ok {
+			for name, definition := range defs {
+				definitions[name] = definition
+			}
+		}
+	}
+
+	return definitions
+}
+
+// SecretParameters returns the names of the parameters a parameter schema
+// marks as secret, sorted. Pydantic marks `SecretStr` parameters, and
+// optional ones through `anyOf`, as `writeOnly` with a `password` format.
+func SecretParameters(parameterSchema map[string]interface{}) []string {
+	v := parameterValidator{definitions: parameterSchemaDefinitions(parameterSchema)}
+
+	properties, _ := parameterSchema["properties"].(map[string]interface{})
+	names := make([]string, 0)
+	for name, property := range properties {
+		propertySchema, ok := property.(map[string]interface{})
+		if ok && v.isSecret(propertySchema, 0) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// isSecret reports whether a schema, or any schema of its `anyOf`, is
+// `writeOnly` or has a `password` format.
+func (v *parameterValidator) isSecret(schema map[string]interface{}, depth int) bool {
+	schema = v.resolve(schema, depth)
+	if schema == nil || depth >= maxParameterSchemaDepth {
+		return false
+	}
+
+	if writeOnly, ok := schema["writeOnly"].(bool); ok && writeOnly {
+		return true
+	}
+	if format, ok := schema["format"].(string); ok && format == "password" {
+		return true
+	}
+
+	anyOf, _ := schema["anyOf"].([]interface{})
+	for _, option := range anyOf {
+		if optionSchema, ok := option.(map[string]interface{}); ok && v.isSecret(optionSchema, depth+1) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type parameterValidator struct {
+	definitions map[string]interface{}
+	violations  []ParameterSchemaViolation
+}
+
+func (v *parameterValidator) addViolation(path, format string, args ...interface{}) {
+	v.violations = append(v.violations, ParameterSchemaViolation{
+		Path:    path,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// resolve follows `$ref` and single-element `allOf` schemas, which is how
+// Pydantic models used as parameters are referenced in a parameter schema.
+func (v *parameterValidator) resolve(schema map[string]interface{}, depth int) map[string]interface{} {
+	for ; depth < maxParameterSchemaDepth; depth++ {
+		if ref, ok := schema["$ref"].(string); ok {
+			name := ref[strings.LastIndex(ref, "/")+1:]
+			definition, ok := v.definitions[name].(map[string]interface{})
+			if !ok {
+				return nil
+			}
+			schema = definition
+
+			continue
+		}
+
+		if allOf, ok := schema["allOf"].([]interface{}); ok && len(allOf) == 1 {
+			if inner, ok := allOf[0].(map[string]interface{}); ok {
+				schema = inner
+
+				continue
+			}
+		}
+
+		return schema
+	}
+
+	return nil
+}
+
+func (v *parameterValidator) validateObject(path string, schema, object map[string]interface{}, depth int) {
+	if required, ok := schema["required"].([]interface{}); ok {
+		for _, name := range required {
+			name, ok := name.(string)
+			if !ok {
+				continue
+			}
+			if _, ok := object[name]; !ok {
+				v.addViolation(path+"."+name, "required parameter is missing")
+			}
+		}
+	}
+
+	// The flow's parameters themselves (at depth 0) only accept the keys
+	// in the schema, unless the flow takes arbitrary keyword arguments.
+	additionalProperties, hasAdditionalProperties := schema["additionalProperties"]
+	rejectUnknown := additionalProperties == false || (depth == 0 && !hasAdditionalProperties)
+
+	properties, _ := schema["properties"].(map[string]interface{})
+	if properties == nil && !rejectUnknown {
+		return
+	}
+
+	// Sort the keys so that violations are reported in a stable order.
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		property, ok := properties[key].(map[string]interface{})
+		if !ok {
+			if _, defined := properties[key]; !defined && rejectUnknown {
+				v.addViolation(path+"."+key, "parameter is not defined in the schema")
+			}
+
+			continue
+		}
+		v.validateValue(path+"."+key, property, object[key], depth+1)
+	}
+}
+
+func (v *parameterValidator) validateValue(path string, schema map[string]interface{}, value interface{}, depth int) {
+	if depth >= maxParameterSchemaDepth {
+		return
+	}
+
+	schema = v.resolve(schema, depth)
+	if schema == nil || value == nil {
+		return
+	}
+
+	expected, ok := schema["type"].(string)
+	if !ok {
+		if _, hasProperties := schema["properties"]; !hasProperties {
+			return
+		}
+		expected = "object"
+	}
+
+	switch expected {
+	case "object":
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			break
+		}
+		v.validateObject(path, schema, object, depth)
+
+		return
+	case "array":
+		elements, ok := value.([]interface{})
+		if !ok {
+			break
+		}
+		// Tuples, e.g. `tuple[str, int]`, have a schema per position in
+		// `prefixItems`, and `items` applies to the elements after those.
+		prefixItems, _ := schema["prefixItems"].([]interface{})
+		items, _ := schema["items"].(map[string]interface{})
+		for i, element := range elements {
+			itemSchema := items
+			if i < len(prefixItems) {
+				itemSchema, _ = prefixItems[i].(map[string]interface{})
+			}
+			if itemSchema != nil {
+				v.validateValue(fmt.Sprintf("%s[%d]", path, i), itemSchema, element, depth+1)
+			}
+		}
+
+		return
+	case "string":
+		if _, ok := value.(string); ok {
+			return
+		}
+	case "integer":
+		if number, ok := value.(float64); ok && number == math.Trunc(number) {
+			return
+		}
+	case "number":
+		if _, ok := value.(float64); ok {
+			return
+		}
+	case "boolean":
+		if _, ok := value.(bool); ok {
+			return
+		}
+	default:
+		return
+	}
+
+	v.addViolation(path, "expected a value of type %s, but got %s", expected, jsonTypeName(value))
+}
+
+// jsonTypeName returns the JSON schema type name of a value decoded from JSON.
+func jsonTypeName(value interface{}) string {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case nil:
+		return "null"
+	case float64:
+		if value == math.Trunc(value) {
+			return "integer"
+		}
+
+		return "number"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+// InferParameterSchema returns a minimal parameter schema for the given
+// deployment parameters. This is a best-effort inference: each parameter is
+// typed after its current value, nested objects and arrays aren't described
+// any further, and no parameter is required. Parameters set to null are
+// left untyped.
+func InferParameterSchema(parameters map[string]interface{}) map[string]interface{} {
+	properties := make(map[string]interface{}, len(parameters))
+	for name, value := range parameters {
+		property := map[string]interface{}{"title": name}
+		if value != nil {
+			property["type"] = jsonTypeName(value)
+		}
+		properties[name] = property
+	}
+
+	return map[string]interface{}{
+		"type":       "object",
+		"title":      "Parameters",
+		"properties": properties,
+	}
+}
@@ -0,0 +1,50 @@
+package helpers
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+// ProviderData is passed by the provider to the Configure method of every
+// resource and data source: the API client, along with the provider
+// settings, which change how resources behave but aren't part of the API.
+type ProviderData struct {
+	Client   api.PrefectClient
+	Settings ProviderSettings
+}
+
+// ProviderSettings holds the provider configuration used by resources and
+// data sources, other than what's needed to call the API.
+type ProviderSettings struct {
+	// DefaultWorkspaceID is the workspace set in the provider, used to look
+	// up DefaultPausedByWorkspace for resources that don't set a workspace.
+	DefaultWorkspaceID uuid.UUID
+
+	// DefaultPausedByWorkspace is, per workspace ID, whether deployments
+	// that don't set `paused` explicitly start paused.
+	DefaultPausedByWorkspace map[uuid.UUID]bool
+
+	// DefaultTags are the `key:value` tags merged into the tags of
+	// deployments, flows and variables.
+	DefaultTags map[string]string
+
+	// EnforceParameterSchemaWhenProvided is whether deployments that provide
+	// a parameter schema, but don't set `enforce_parameter_schema`
+	// explicitly, enforce it.
+	EnforceParameterSchemaWhenProvided bool
+
+	// APIKeyExpirationWarningDays is how many days before expiring a service
+	// account's API key is warned about. A zero value disables the warning.
+	APIKeyExpirationWarningDays int64
+}
+
+// DefaultPaused returns whether deployments in a workspace that don't set
+// `paused` explicitly start paused. A nil workspace ID is the provider's.
+func (s ProviderSettings) DefaultPaused(workspaceID uuid.UUID) bool {
+	if workspaceID == uuid.Nil {
+		workspaceID = s.DefaultWorkspaceID
+	}
+
+	return s.DefaultPausedByWorkspace[workspaceID]
+}
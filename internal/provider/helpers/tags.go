@@ -0,0 +1,65 @@
+package helpers
+
+import (
+	"sort"
+	"strings"
+)
+
+// tagKey returns the key of a `key:value` tag, or the whole tag if it
+// has no value.
+func tagKey(tag string) string {
+	key, _, _ := strings.Cut(tag, ":")
+
+	return key
+}
+
+// MergeDefaultTags appends the provider's default tags, as `key:value`
+// tags sorted by key, to a resource's tags.
+//
+// Resource tags take precedence: a default tag is skipped when the resource
+// already has a tag with the same key, e.g. `team:platform` wins over a
+// `team` default, so the resource can override any default.
+func MergeDefaultTags(tags []string, defaultTags map[string]string) []string {
+	merged := make([]string, 0, len(tags)+len(defaultTags))
+	merged = append(merged, tags...)
+
+	if len(defaultTags) == 0 {
+		return merged
+	}
+
+	keys := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		keys[tagKey(tag)] = true
+	}
+
+	defaultKeys := make([]string, 0, len(defaultTags))
+	for key := range defaultTags {
+		defaultKeys = append(defaultKeys, key)
+	}
+	sort.Strings(defaultKeys)
+
+	for _, key := range defaultKeys {
+		if keys[key] {
+			continue
+		}
+		merged = append(merged, key+":"+defaultTags[key])
+	}
+
+	return merged
+}
+
+// RemoveDefaultTags returns a resource's tags without the provider's
+// default tags, e.g. to find the tags to import from the tags returned
+// by the API. Tags that only share a key with a default tag are kept.
+func RemoveDefaultTags(tags []string, defaultTags map[string]string) []string {
+	removed := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		key, value, ok := strings.Cut(tag, ":")
+		if defaultValue, isDefault := defaultTags[key]; ok && isDefault && value == defaultValue {
+			continue
+		}
+		removed = append(removed, tag)
+	}
+
+	return removed
+}
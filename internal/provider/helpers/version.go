@@ -0,0 +1,65 @@
+package helpers
+
+import (
+	"strconv"
+	"strings"
+)
+
+// IsVersionAtLeast returns whether a Prefect version, e.g. `3.0.4` or
+// `3.1.0.dev2`, is at least the minimum version, comparing the major, minor
+// and patch numbers only. The second result is false if the version
+// can't be parsed.
+func IsVersionAtLeast(version, minimum string) (bool, bool) {
+	parsedVersion, ok := parseVersion(version)
+	if !ok {
+		return false, false
+	}
+
+	parsedMinimum, ok := parseVersion(minimum)
+	if !ok {
+		return false, false
+	}
+
+	for i := range parsedVersion {
+		if parsedVersion[i] != parsedMinimum[i] {
+			return parsedVersion[i] > parsedMinimum[i], true
+		}
+	}
+
+	return true, true
+}
+
+// parseVersion parses the leading major, minor and patch numbers of a version,
+// ignoring any pre-release or local suffix. Missing numbers default to 0.
+func parseVersion(version string) ([3]int, bool) {
+	var parsed [3]int
+
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	parts := strings.SplitN(version, ".", len(parsed)+1)
+
+	for i := 0; i < len(parsed) && i < len(parts); i++ {
+		// Keep the leading digits only, e.g. `0rc1` or `0+12.gabcdef` become 0.
+		digits := parts[i]
+		if end := strings.IndexFunc(digits, func(r rune) bool { return r < '0' || r > '9' }); end >= 0 {
+			digits = digits[:end]
+		}
+
+		number, err := strconv.Atoi(digits)
+		if err != nil {
+			// Only the major number is required.
+			if i == 0 {
+				return parsed, false
+			}
+
+			break
+		}
+		parsed[i] = number
+
+		// A suffix ends the version, e.g. the `.dev2` of `3.1rc1.dev2`.
+		if len(digits) != len(parts[i]) {
+			break
+		}
+	}
+
+	return parsed, true
+}
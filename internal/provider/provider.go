@@ -3,9 +3,11 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"net/url"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -13,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/prefecthq/terraform-provider-prefect/internal/client"
@@ -44,11 +47,21 @@ func (p *PrefectProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 				Description: "Prefect API URL. Can also be set via the `PREFECT_API_URL` environment variable. Defaults to `https://api.prefect.cloud`",
 				Optional:    true,
 			},
+			"api_prefix": schema.StringAttribute{
+				Description: "Path the Prefect API is served under, appended to `endpoint` unless it already ends with it, e.g. `/prefect/api` for a self-hosted Prefect server behind a reverse proxy. Set to an empty string when the API is served at the root of `endpoint`. Defaults to `/api`",
+				Optional:    true,
+			},
 			"api_key": schema.StringAttribute{
 				Description: "Prefect Cloud API Key. Can also be set via the `PREFECT_API_KEY` environment variable.",
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"api_key_file": schema.StringAttribute{
+				Description: "Path to a file containing the Prefect Cloud API Key, e.g. a short-lived token written and rotated by an OIDC integration. " +
+					"The file is read before each request, so a rotated key is picked up without restarting. Takes precedence over `api_key` when set. " +
+					"Can also be set via the `PREFECT_API_KEY_FILE` environment variable.",
+				Optional: true,
+			},
 			"account_id": schema.StringAttribute{
 				CustomType:  customtypes.UUIDType{},
 				Description: "Default Prefect Cloud Account ID. Can also be set via the `PREFECT_CLOUD_ACCOUNT_ID` environment variable.",
@@ -59,6 +72,39 @@ func (p *PrefectProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 				Description: "Default Prefect Cloud Workspace ID.",
 				Optional:    true,
 			},
+			"http_headers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Description: "Custom HTTP headers sent with every request to the Prefect API, e.g. `{ \"X-Tenant\" = \"data\" }` for a gateway in front of the API. The `Authorization` header can't be set, as it's reserved for the API Key.",
+				Optional:    true,
+			},
+			"default_paused_by_workspace": schema.MapAttribute{
+				ElementType: types.BoolType,
+				Description: "Whether deployments start paused, keyed by Workspace ID (UUID). Applies to deployments that don't set `paused`; deployments in workspaces not listed here are not paused.",
+				Optional:    true,
+			},
+			"default_tags": schema.MapAttribute{
+				ElementType: types.StringType,
+				Description: "Tags added to every deployment, flow and variable, as `key:value` tags, e.g. `{ team = \"data\" }` adds the `team:data` tag. " +
+					"They are merged in after the tags the resource sets, and the merged list is stored in the resource's `tags_all`, while `tags` stays as configured. " +
+					"Resource tags take precedence: a default is skipped when the resource already has a tag with the same key, e.g. `team:platform` or `team`.",
+				Optional: true,
+			},
+			"enforce_parameter_schema_when_provided": schema.BoolAttribute{
+				Description: "Whether deployments that set `parameter_openapi_schema` enforce it by default. Applies to deployments that don't set `enforce_parameter_schema`, which otherwise defaults to `false`.",
+				Optional:    true,
+			},
+			"api_key_expiration_warning_days": schema.Int64Attribute{
+				Description: "Number of days before a service account's API Key expires to start warning about it at plan time, so the key can be rotated ahead of time. Applies to `prefect_service_account` resources and data sources. Not warned about by default.",
+				Optional:    true,
+			},
+			"rate_limit": schema.Float64Attribute{
+				Description: "Maximum number of requests per second sent to the Prefect API, shared across all resources and data sources. When the API still responds with `429 Too Many Requests`, the rate is reduced and the request is retried after the `Retry-After` delay. Not limited by default.",
+				Optional:    true,
+			},
+			"request_timeout": schema.Int64Attribute{
+				Description: "Number of seconds each request to the Prefect API may take, including reading the response, before it fails. Set to `0` to disable the timeout. Defaults to `60`.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -84,6 +130,15 @@ func (p *PrefectProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 	}
 
+	if config.APIPrefix.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_prefix"),
+			"Unknown Prefect API Prefix",
+			"The Prefect API prefix is not known at configuration time. "+
+				"Potential resolutions: target apply the source of the value first, set the value statically in the configuration, or remove the value.",
+		)
+	}
+
 	if config.APIKey.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("api_key"),
@@ -93,6 +148,15 @@ func (p *PrefectProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 	}
 
+	if config.APIKeyFile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_key_file"),
+			"Unknown Prefect API Key File",
+			"The Prefect API Key file is not known at configuration time. "+
+				"Potential resolutions: target apply the source of the value first, set the value statically in the configuration, set the PREFECT_API_KEY_FILE environment variable, or remove the value.",
+		)
+	}
+
 	if config.AccountID.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("account_id"),
@@ -111,6 +175,93 @@ func (p *PrefectProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 	}
 
+	if config.DefaultPausedByWorkspace.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_paused_by_workspace"),
+			"Unknown Prefect paused defaults",
+			"The default_paused_by_workspace map is not known at configuration time. "+
+				"Potential resolutions: target apply the source of the value first, set the value statically in the configuration, or remove the value.",
+		)
+	}
+
+	if config.HTTPHeaders.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("http_headers"),
+			"Unknown Prefect HTTP headers",
+			"The http_headers map is not known at configuration time. "+
+				"Potential resolutions: target apply the source of the value first, set the value statically in the configuration, or remove the value.",
+		)
+	}
+
+	if config.DefaultTags.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_tags"),
+			"Unknown Prefect default tags",
+			"The default_tags map is not known at configuration time. "+
+				"Potential resolutions: target apply the source of the value first, set the value statically in the configuration, or remove the value.",
+		)
+	}
+
+	if config.EnforceParameterSchemaWhenProvided.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("enforce_parameter_schema_when_provided"),
+			"Unknown Prefect parameter schema enforcement default",
+			"The enforce_parameter_schema_when_provided value is not known at configuration time. "+
+				"Potential resolutions: target apply the source of the value first, set the value statically in the configuration, or remove the value.",
+		)
+	}
+
+	if config.RateLimit.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rate_limit"),
+			"Unknown Prefect API rate limit",
+			"The rate_limit value is not known at configuration time. "+
+				"Potential resolutions: target apply the source of the value first, set the value statically in the configuration, or remove the value.",
+		)
+	}
+
+	if config.RequestTimeout.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("request_timeout"),
+			"Unknown Prefect API request timeout",
+			"The request_timeout value is not known at configuration time. "+
+				"Potential resolutions: target apply the source of the value first, set the value statically in the configuration, or remove the value.",
+		)
+	}
+
+	if config.APIKeyExpirationWarningDays.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_key_expiration_warning_days"),
+			"Unknown Prefect API Key expiration warning threshold",
+			"The api_key_expiration_warning_days value is not known at configuration time. "+
+				"Potential resolutions: target apply the source of the value first, set the value statically in the configuration, or remove the value.",
+		)
+	}
+
+	if config.APIKeyExpirationWarningDays.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_key_expiration_warning_days"),
+			"Invalid Prefect API Key expiration warning threshold",
+			fmt.Sprintf("The api_key_expiration_warning_days value must not be negative: api_key_expiration_warning_days is %d.", config.APIKeyExpirationWarningDays.ValueInt64()),
+		)
+	}
+
+	if config.RequestTimeout.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("request_timeout"),
+			"Invalid Prefect API request timeout",
+			fmt.Sprintf("The request_timeout value must not be negative: request_timeout is %d.", config.RequestTimeout.ValueInt64()),
+		)
+	}
+
+	if !config.RateLimit.IsNull() && !config.RateLimit.IsUnknown() && config.RateLimit.ValueFloat64() <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rate_limit"),
+			"Invalid Prefect API rate limit",
+			fmt.Sprintf("The rate_limit value must be a positive number of requests per second: rate_limit is %v.", config.RateLimit.ValueFloat64()),
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -126,9 +277,15 @@ func (p *PrefectProvider) Configure(ctx context.Context, req provider.ConfigureR
 	if endpoint == "" {
 		endpoint = "https://api.prefect.cloud"
 	}
-	// Here, we'll ensure that the /api suffix is present on the endpoint
-	if !strings.HasSuffix(endpoint, "/api") {
-		endpoint = fmt.Sprintf("%s/api", endpoint)
+	// Here, we'll ensure that the API prefix, /api unless configured
+	// otherwise, is present on the endpoint, regardless of trailing slashes.
+	apiPrefix := "/api"
+	if !config.APIPrefix.IsNull() {
+		apiPrefix = "/" + strings.Trim(config.APIPrefix.ValueString(), "/")
+	}
+	endpoint = strings.TrimRight(endpoint, "/")
+	if apiPrefix != "/" && !strings.HasSuffix(endpoint, apiPrefix) {
+		endpoint += apiPrefix
 	}
 
 	endpointURL, err := url.Parse(endpoint)
@@ -149,6 +306,25 @@ func (p *PrefectProvider) Configure(ctx context.Context, req provider.ConfigureR
 		apiKey = apiKeyEnvVar
 	}
 
+	// Extract the API Key file from configuration or environment variable.
+	// It's checked now to catch a misconfigured path early, and read
+	// before each request.
+	var apiKeyFile string
+	if !config.APIKeyFile.IsNull() {
+		apiKeyFile = config.APIKeyFile.ValueString()
+	} else if apiKeyFileEnvVar, ok := os.LookupEnv("PREFECT_API_KEY_FILE"); ok {
+		apiKeyFile = apiKeyFileEnvVar
+	}
+	if apiKeyFile != "" {
+		if _, err := os.Stat(apiKeyFile); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("api_key_file"),
+				"Invalid Prefect API Key File",
+				fmt.Sprintf("The Prefect API Key file %q can't be read: %s", apiKeyFile, err),
+			)
+		}
+	}
+
 	// Extract the Account ID from configuration or environment variable.
 	// If the ID is set to an invalid UUID, emit an error.
 	var accountID uuid.UUID
@@ -170,12 +346,12 @@ func (p *PrefectProvider) Configure(ctx context.Context, req provider.ConfigureR
 	// Additionally, we will warn if an Account ID is missing,
 	// as it's likely that this is a user misconfiguration.
 	if isPrefectCloudEndpoint {
-		if apiKey == "" {
+		if apiKey == "" && apiKeyFile == "" {
 			resp.Diagnostics.AddAttributeError(
 				path.Root("api_key"),
 				"Missing Prefect API Key",
 				"The Prefect API Endpoint is configured to Prefect Cloud, however, the Prefect API Key is empty. "+
-					"Potential resolutions: set the endpoint attribute or PREFECT_API_URL environment variable to a Prefect server installation, set the PREFECT_API_KEY environment variable, or configure the api_key attribute.",
+					"Potential resolutions: set the endpoint attribute or PREFECT_API_URL environment variable to a Prefect server installation, set the PREFECT_API_KEY environment variable, or configure the api_key or api_key_file attribute.",
 			)
 		}
 
@@ -189,6 +365,64 @@ func (p *PrefectProvider) Configure(ctx context.Context, req provider.ConfigureR
 		}
 	}
 
+	// The custom headers can't override the Authorization header set from the API Key.
+	httpHeaders := map[string]string{}
+	if !config.HTTPHeaders.IsNull() {
+		resp.Diagnostics.Append(config.HTTPHeaders.ElementsAs(ctx, &httpHeaders, false)...)
+
+		for key := range httpHeaders {
+			if http.CanonicalHeaderKey(key) == "Authorization" {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("http_headers").AtMapKey(key),
+					"Invalid Prefect HTTP header",
+					fmt.Sprintf("The http_headers key %q must not be set, as the Authorization header is set from the api_key or api_key_file attribute.", key),
+				)
+			}
+		}
+	}
+
+	// Parse the per-workspace paused defaults, keyed by workspace ID.
+	defaultPausedByWorkspace := map[uuid.UUID]bool{}
+	if !config.DefaultPausedByWorkspace.IsNull() {
+		var rawDefaults map[string]bool
+		resp.Diagnostics.Append(config.DefaultPausedByWorkspace.ElementsAs(ctx, &rawDefaults, false)...)
+
+		for rawWorkspaceID, paused := range rawDefaults {
+			workspaceID, err := uuid.Parse(rawWorkspaceID)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("default_paused_by_workspace").AtMapKey(rawWorkspaceID),
+					"Invalid Prefect Workspace ID",
+					fmt.Sprintf("The default_paused_by_workspace key %q is not a valid UUID: %s", rawWorkspaceID, err),
+				)
+
+				continue
+			}
+			defaultPausedByWorkspace[workspaceID] = paused
+		}
+	}
+
+	// Parse the default tags, whose keys can't contain the `:` separator.
+	defaultTags := map[string]string{}
+	if !config.DefaultTags.IsNull() {
+		resp.Diagnostics.Append(config.DefaultTags.ElementsAs(ctx, &defaultTags, false)...)
+
+		for key := range defaultTags {
+			if key == "" || strings.Contains(key, ":") {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("default_tags").AtMapKey(key),
+					"Invalid Prefect default tag",
+					fmt.Sprintf("The default_tags key %q must not be empty or contain a colon, as tags are formatted as `key:value`.", key),
+				)
+			}
+		}
+	}
+
+	requestTimeout := client.DefaultRequestTimeout
+	if !config.RequestTimeout.IsNull() {
+		requestTimeout = time.Duration(config.RequestTimeout.ValueInt64()) * time.Second
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -203,7 +437,11 @@ func (p *PrefectProvider) Configure(ctx context.Context, req provider.ConfigureR
 	prefectClient, err := client.New(
 		client.WithEndpoint(endpoint),
 		client.WithAPIKey(apiKey),
+		client.WithAPIKeyFile(apiKeyFile),
+		client.WithHTTPHeaders(httpHeaders),
 		client.WithDefaults(accountID, config.WorkspaceID.ValueUUID()),
+		client.WithRateLimit(config.RateLimit.ValueFloat64()),
+		client.WithRequestTimeout(requestTimeout),
 	)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -216,9 +454,19 @@ func (p *PrefectProvider) Configure(ctx context.Context, req provider.ConfigureR
 	}
 	p.client = prefectClient
 
-	// Pass client to DataSource and Resource type Configure methods
-	resp.DataSourceData = prefectClient
-	resp.ResourceData = prefectClient
+	// Pass client and settings to DataSource and Resource type Configure methods
+	data := &helpers.ProviderData{
+		Client: prefectClient,
+		Settings: helpers.ProviderSettings{
+			DefaultWorkspaceID:                 config.WorkspaceID.ValueUUID(),
+			DefaultPausedByWorkspace:           defaultPausedByWorkspace,
+			DefaultTags:                        defaultTags,
+			EnforceParameterSchemaWhenProvided: config.EnforceParameterSchemaWhenProvided.ValueBool(),
+			APIKeyExpirationWarningDays:        config.APIKeyExpirationWarningDays.ValueInt64(),
+		},
+	}
+	resp.DataSourceData = data
+	resp.ResourceData = data
 
 	tflog.Info(ctx, "Configured Prefect client", map[string]any{"success": true})
 }
@@ -231,10 +479,15 @@ func (p *PrefectProvider) DataSources(_ context.Context) []func() datasource.Dat
 		datasources.NewAccountMembersDataSource,
 		datasources.NewAccountRoleDataSource,
 		datasources.NewBlockDataSource,
+		datasources.NewBlocksDataSource,
+		datasources.NewDeploymentValidationDataSource,
+		datasources.NewDeploymentsDataSource,
+		datasources.NewFlowDataSource,
 		datasources.NewServiceAccountDataSource,
 		datasources.NewTeamDataSource,
 		datasources.NewTeamsDataSource,
 		datasources.NewVariableDataSource,
+		datasources.NewVariablesDataSource,
 		datasources.NewWorkerMetadataDataSource,
 		datasources.NewWorkPoolDataSource,
 		datasources.NewWorkPoolsDataSource,
@@ -248,9 +501,15 @@ func (p *PrefectProvider) Resources(_ context.Context) []func() resource.Resourc
 	return []func() resource.Resource{
 		resources.NewAccountResource,
 		resources.NewFlowResource,
+		resources.NewFlowRunNotificationPolicyResource,
+		resources.NewGlobalConcurrencyLimitResource,
 		resources.NewDeploymentResource,
+		resources.NewDeploymentSLAResource,
 		resources.NewServiceAccountResource,
+		resources.NewTagConcurrencyLimitResource,
 		resources.NewVariableResource,
+		resources.NewVariablesResource,
+		resources.NewWebhookResource,
 		resources.NewWorkPoolResource,
 		resources.NewWorkspaceAccessResource,
 		resources.NewWorkspaceResource,
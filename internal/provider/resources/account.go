@@ -2,6 +2,8 @@ package resources
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strconv"
 
 	"github.com/google/uuid"
@@ -62,14 +64,14 @@ func (r *AccountResource) Configure(_ context.Context, req resource.ConfigureReq
 		return
 	}
 
-	client, ok := req.ProviderData.(api.PrefectClient)
+	data, ok := req.ProviderData.(*helpers.ProviderData)
 	if !ok {
 		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("resource", req.ProviderData))
 
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
 }
 
 // Schema defines the schema for the resource.
@@ -78,8 +80,8 @@ func (r *AccountResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 		Description: "The resource `account` represents a Prefect Cloud account. " +
 			"It is used to manage the account's attributes, such as the name, handle, and location.\n" +
 			"\n" +
-			"Note that this resource can only be imported, as account creation is not currently supported " +
-			"via the API. Additionally, be aware that account deletion is possible once it is imported, " +
+			"Accounts can be created with `name`, `handle` and optional `settings`, or an existing account can be imported. " +
+			"Be aware that account deletion is possible once the resource is managed by Terraform, " +
 			"so be attentive to any destroy plans or unlink the resource through `terraform state rm`.",
 		Version: 0,
 		Attributes: map[string]schema.Attribute{
@@ -183,8 +185,103 @@ func copyAccountToModel(_ context.Context, account *api.AccountResponse, tfModel
 	return diags
 }
 
-func (r *AccountResource) Create(_ context.Context, _ resource.CreateRequest, resp *resource.CreateResponse) {
-	resp.Diagnostics.AddError("Cannot create account", "Account is an import-only resource and cannot be created by Terraform.")
+func (r *AccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AccountResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A new account has no ID yet, so it's created without the account
+	// scoped client, which would need the provider's account_id.
+	creationClient, err := r.client.AccountCreation()
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Account", err))
+
+		return
+	}
+
+	var settings *api.AccountSettings
+	if !plan.Settings.IsNull() && !plan.Settings.IsUnknown() {
+		accountSettings := newAccountSettingsFromObject(plan.Settings)
+		settings = &accountSettings
+	}
+
+	account, err := creationClient.Create(ctx, api.AccountCreate{
+		Name:     plan.Name.ValueString(),
+		Handle:   plan.Handle.ValueString(),
+		Settings: settings,
+	})
+	if errors.Is(err, api.ErrAccountHandleTaken) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("handle"),
+			"Account handle already taken",
+			fmt.Sprintf("Account handles are globally unique, and %s. "+
+				"Potential resolutions: choose a different handle, or import the existing account with `terraform import`.", err),
+		)
+
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Account", "create", err))
+
+		return
+	}
+
+	// The account exists from here on, so it's saved to state before the
+	// follow-up update, so that it isn't orphaned if the update fails.
+	planned := plan
+	resp.Diagnostics.Append(copyAccountToModel(ctx, account, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The remaining attributes are not part of the create payload,
+	// so they are applied to the new account in a follow-up update.
+	if !planned.Location.IsNull() || !planned.Link.IsNull() || !planned.BillingEmail.IsNull() {
+		client, err := r.client.Accounts(account.ID)
+		if err != nil {
+			resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Account", err))
+
+			return
+		}
+
+		err = client.Update(ctx, api.AccountUpdate{
+			Name:         planned.Name.ValueStringPointer(),
+			Handle:       planned.Handle.ValueStringPointer(),
+			Location:     planned.Location.ValueStringPointer(),
+			Link:         planned.Link.ValueStringPointer(),
+			BillingEmail: planned.BillingEmail.ValueStringPointer(),
+		})
+		if err != nil {
+			resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Account", "update", err))
+
+			return
+		}
+
+		account, err = client.Get(ctx)
+		if err != nil {
+			resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Account", "get", err))
+
+			return
+		}
+
+		resp.Diagnostics.Append(copyAccountToModel(ctx, account, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -1,7 +1,12 @@
 package resources_test
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -18,11 +23,11 @@ func TestAccResource_account(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Import State checks - import by ID (from environment)
 			// NOTE: the prefect_account resource is a little special in that
-			// we cannot create an account via API, meaning the TF lifecycle
-			// will be challenging to test. Instead, we'll ensure that the
-			// resource can be found and properly imported. Note that
-			// ImportStateVerify is set to false, as the resource can't be
-			// saved to state after a Create.
+			// creating an account provisions a new, non-ephemeral Cloud account,
+			// meaning the TF lifecycle will be challenging to test in CI.
+			// Instead, we'll ensure that the resource can be found and
+			// properly imported. Note that ImportStateVerify is set to false,
+			// as the placeholder config has no name or handle to verify against.
 			{
 				Config:            `resource "prefect_account" "test" {}`,
 				ImportStateId:     os.Getenv("PREFECT_CLOUD_ACCOUNT_ID"),
@@ -33,3 +38,44 @@ func TestAccResource_account(t *testing.T) {
 		},
 	})
 }
+
+func fixtureAccAccountMock(endpoint string) string {
+	return fmt.Sprintf(`
+provider "prefect" {
+	endpoint = "%s"
+}
+
+resource "prefect_account" "test" {
+	name = "Test Account"
+	handle = "taken-handle"
+}
+`, endpoint)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_account_handle_taken(t *testing.T) {
+	// The server responds to account creation as if the handle is taken.
+	// No account_id is configured, as when creating a new account.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/accounts/") {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"detail": "Account handle already exists."}`))
+
+			return
+		}
+
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Check that a taken handle is surfaced on the handle attribute
+				Config:      fixtureAccAccountMock(server.URL),
+				ExpectError: regexp.MustCompile(`Account handle already taken`),
+			},
+		},
+	})
+}
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -22,6 +24,12 @@ import (
 	"github.com/prefecthq/terraform-provider-prefect/internal/utils"
 )
 
+var (
+	_ = resource.ResourceWithConfigure(&BlockResource{})
+	_ = resource.ResourceWithImportState(&BlockResource{})
+	_ = resource.ResourceWithValidateConfig(&BlockResource{})
+)
+
 type BlockResource struct {
 	client api.PrefectClient
 }
@@ -33,9 +41,10 @@ type BlockResourceModel struct {
 	AccountID   customtypes.UUIDValue      `tfsdk:"account_id"`
 	WorkspaceID customtypes.UUIDValue      `tfsdk:"workspace_id"`
 
-	Name     types.String         `tfsdk:"name"`
-	TypeSlug types.String         `tfsdk:"type_slug"`
-	Data     jsontypes.Normalized `tfsdk:"data"`
+	Name        types.String         `tfsdk:"name"`
+	TypeSlug    types.String         `tfsdk:"type_slug"`
+	Data        jsontypes.Normalized `tfsdk:"data"`
+	IsAnonymous types.Bool           `tfsdk:"is_anonymous"`
 }
 
 // NewBlockResource returns a new BlockResource.
@@ -56,14 +65,14 @@ func (r *BlockResource) Configure(_ context.Context, req resource.ConfigureReque
 		return
 	}
 
-	client, ok := req.ProviderData.(api.PrefectClient)
+	data, ok := req.ProviderData.(*helpers.ProviderData)
 	if !ok {
 		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("resource", req.ProviderData))
 
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
 }
 
 func (r *BlockResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -102,8 +111,21 @@ func (r *BlockResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Description: "Timestamp of when the resource was updated (RFC3339)",
 			},
 			"name": schema.StringAttribute{
-				Required:    true,
-				Description: "Unique name of the Block",
+				Optional:    true,
+				Computed:    true,
+				Description: "Unique name of the Block. Required, unless `is_anonymous` is set, in which case the name is assigned by the server.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_anonymous": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the Block is anonymous. Anonymous Blocks are system-managed, have no user-facing name, and are typically created by other Prefect objects such as deployment storage.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
 			},
 			"type_slug": schema.StringAttribute{
 				Required:    true,
@@ -116,7 +138,7 @@ func (r *BlockResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Required:    true,
 				Sensitive:   true,
 				CustomType:  jsontypes.NormalizedType{},
-				Description: "The user-inputted Block payload, as a JSON string. The value's schema will depend on the selected `type` slug. Use `prefect block type inspect <slug>` to view the data schema for a given Block type.",
+				Description: "The user-inputted Block payload, as a JSON string. The value's schema will depend on the selected `type` slug. Use `prefect block type inspect <slug>` to view the data schema for a given Block type. Secrets that shouldn't be re-supplied on update can be set to `********`, which keeps the current value. This only applies to the fields the Block type's schema lists as secret. Nested Blocks can be referenced with `{\"$ref\": {\"block_document_id\": \"<uuid>\"}}` or `{\"$ref\": {\"block_type_slug\": \"<slug>\", \"block_document_name\": \"<name>\"}}`. The referenced Block is updated in place, so rotating its data, e.g. a secret, keeps the references to it and doesn't change the Blocks referencing it.",
 			},
 			"account_id": schema.StringAttribute{
 				Optional:    true,
@@ -146,10 +168,213 @@ func copyBlockToModel(block *api.BlockDocument, tfModel *BlockResourceModel) dia
 	tfModel.Updated = customtypes.NewTimestampPointerValue(block.Updated)
 	tfModel.Name = types.StringValue(block.Name)
 	tfModel.TypeSlug = types.StringValue(block.BlockType.Slug)
+	tfModel.IsAnonymous = types.BoolValue(block.IsAnonymous)
 
 	return nil
 }
 
+// blockSecretFields returns the dotted paths of the secret fields listed
+// in the block schema's `secret_fields`, or nil if the schema doesn't list them.
+func blockSecretFields(blockSchema *api.BlockSchema) []string {
+	if blockSchema == nil {
+		return nil
+	}
+
+	fields, _ := blockSchema.Fields.(map[string]interface{})
+	rawSecretFields, ok := fields["secret_fields"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	secretFields := make([]string, 0, len(rawSecretFields))
+	for _, rawSecretField := range rawSecretFields {
+		if secretField, ok := rawSecretField.(string); ok {
+			secretFields = append(secretFields, secretField)
+		}
+	}
+
+	return secretFields
+}
+
+// resolveBlockDocumentReferences walks the Block data and resolves any
+// `$ref` expressions to a `block_document_id` before the payload is sent,
+// so that nested Blocks can be referenced either by ID or by type slug + name:
+//
+//	{"$ref": {"block_document_id": "<uuid>"}}
+//	{"$ref": {"block_type_slug": "<slug>", "block_document_name": "<name>"}}
+//
+// Referenced Blocks are looked up to validate that they exist.
+func resolveBlockDocumentReferences(ctx context.Context, client api.BlockDocumentClient, value interface{}) (interface{}, error) {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		if ref, ok := typed["$ref"].(map[string]interface{}); ok && len(typed) == 1 {
+			blockDocumentID, err := resolveBlockDocumentReference(ctx, client, ref)
+			if err != nil {
+				return nil, err
+			}
+
+			return map[string]interface{}{
+				"$ref": map[string]interface{}{"block_document_id": blockDocumentID.String()},
+			}, nil
+		}
+
+		resolved := make(map[string]interface{}, len(typed))
+		for key, nested := range typed {
+			resolvedNested, err := resolveBlockDocumentReferences(ctx, client, nested)
+			if err != nil {
+				return nil, err
+			}
+			resolved[key] = resolvedNested
+		}
+
+		return resolved, nil
+	case []interface{}:
+		resolved := make([]interface{}, len(typed))
+		for i, nested := range typed {
+			resolvedNested, err := resolveBlockDocumentReferences(ctx, client, nested)
+			if err != nil {
+				return nil, err
+			}
+			resolved[i] = resolvedNested
+		}
+
+		return resolved, nil
+	default:
+		return value, nil
+	}
+}
+
+// resolveBlockDocumentReference returns the ID of the Block referenced by a single `$ref` expression.
+func resolveBlockDocumentReference(ctx context.Context, client api.BlockDocumentClient, ref map[string]interface{}) (uuid.UUID, error) {
+	if rawID, ok := ref["block_document_id"].(string); ok {
+		blockDocumentID, err := uuid.Parse(rawID)
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("invalid block_document_id %q in $ref: %w", rawID, err)
+		}
+
+		if _, err := client.Get(ctx, blockDocumentID); err != nil {
+			return uuid.Nil, fmt.Errorf("referenced block %s could not be found: %w", blockDocumentID, err)
+		}
+
+		return blockDocumentID, nil
+	}
+
+	typeSlug, hasSlug := ref["block_type_slug"].(string)
+	name, hasName := ref["block_document_name"].(string)
+	if !hasSlug || !hasName {
+		return uuid.Nil, fmt.Errorf("$ref must contain either block_document_id, or both block_type_slug and block_document_name")
+	}
+
+	blockDocument, err := client.GetByName(ctx, typeSlug, name)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("referenced block %s/%s could not be found: %w", typeSlug, name, err)
+	}
+
+	return blockDocument.ID, nil
+}
+
+// restoreBlockDocumentReferences puts the `$ref` expressions of the state
+// back into Block data read from the API, which returns the data of
+// referenced Blocks inline instead. A reference is kept as long as the API
+// still references the same Block under that field, by ID or by type slug
+// and name, so rotating the referenced Block's data, e.g. a secret, doesn't
+// show up as drift on the Blocks referencing it.
+func restoreBlockDocumentReferences(stateData map[string]interface{}, data interface{}, references map[string]api.BlockDocumentReference) interface{} {
+	typedData, ok := data.(map[string]interface{})
+	if !ok {
+		return data
+	}
+
+	for key, value := range stateData {
+		typedValue, ok := value.(map[string]interface{})
+		if !ok || len(typedValue) != 1 {
+			continue
+		}
+
+		ref, ok := typedValue["$ref"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		reference, ok := references[key]
+		if !ok {
+			continue
+		}
+
+		target := reference.BlockDocument
+		if rawID, ok := ref["block_document_id"].(string); ok {
+			if blockDocumentID, err := uuid.Parse(rawID); err != nil || blockDocumentID != target.ID {
+				continue
+			}
+		} else if ref["block_type_slug"] != target.BlockType.Slug || ref["block_document_name"] != target.Name {
+			continue
+		}
+
+		typedData[key] = value
+	}
+
+	return typedData
+}
+
+// blockDataForAPI unmarshals the user-provided `data` JSON string and
+// resolves any nested Block references in it.
+func blockDataForAPI(ctx context.Context, client api.BlockDocumentClient, value jsontypes.Normalized) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var data map[string]interface{}
+	diags.Append(value.Unmarshal(&data)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for key, nested := range data {
+		resolved, err := resolveBlockDocumentReferences(ctx, client, nested)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("data"),
+				"Invalid Block reference",
+				fmt.Sprintf("Could not resolve the `$ref` under %q in the Block data: %s", key, err.Error()),
+			)
+
+			return nil, diags
+		}
+		data[key] = resolved
+	}
+
+	return data, diags
+}
+
+// ValidateConfig ensures that a name is only set on named Blocks.
+func (r *BlockResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config BlockResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.IsAnonymous.IsUnknown() || config.Name.IsUnknown() {
+		return
+	}
+
+	isAnonymous := config.IsAnonymous.ValueBool()
+
+	if isAnonymous && !config.Name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Name set on anonymous Block",
+			"Anonymous Blocks are named by the server, so `name` must not be set when `is_anonymous` is true.",
+		)
+	}
+
+	if !isAnonymous && config.Name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Missing Block name",
+			"The `name` attribute is required, unless `is_anonymous` is true.",
+		)
+	}
+}
+
 // Create will create the Block resource through the API and insert it into the State.
 func (r *BlockResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan BlockResourceModel
@@ -230,17 +455,20 @@ func (r *BlockResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Here, we unmarshal the user-provided `data` JSON string to a map[string]interface{}
 	// because we'll later need to re-marshall the entire BlockDocumentCreate payload
 	// when sending it back up to the API
-	var data map[string]interface{}
-	resp.Diagnostics.Append(plan.Data.Unmarshal(&data)...)
+	data, diags := blockDataForAPI(ctx, blockDocumentClient, plan.Data)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Anonymous blocks are named by the server, so we'll leave the name
+	// out of the payload and pick up the generated one from the response.
 	createdBlockDocument, err := blockDocumentClient.Create(ctx, api.BlockDocumentCreate{
 		Name:          plan.Name.ValueString(),
 		Data:          data,
 		BlockSchemaID: latestBlockSchema.ID,
 		BlockTypeID:   latestBlockSchema.BlockTypeID,
+		IsAnonymous:   plan.IsAnonymous.ValueBool(),
 	})
 	if err != nil {
 		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Block Document", "create", err))
@@ -306,7 +534,23 @@ func (r *BlockResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	byteSlice, err := json.Marshal(block.Data)
+	// Any secrets kept as the masked placeholder in the configuration
+	// are masked again, so the real values don't show up as drift.
+	// Only the schema's secret fields are masked, so a non-secret value
+	// that happens to equal the placeholder is still compared.
+	// Referenced Blocks are kept as their `$ref`, rather than their data.
+	var data interface{} = block.Data
+	if !state.Data.IsNull() {
+		var stateData map[string]interface{}
+		resp.Diagnostics.Append(state.Data.Unmarshal(&stateData)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data = helpers.MaskSecretValues(stateData, block.Data, blockSecretFields(block.BlockSchema))
+		data = restoreBlockDocumentReferences(stateData, data, block.BlockDocumentReferences)
+	}
+
+	byteSlice, err := json.Marshal(data)
 	if err != nil {
 		resp.Diagnostics.Append(helpers.SerializeDataErrorDiagnostic("data", "Block Data", err))
 
@@ -382,12 +626,23 @@ func (r *BlockResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	var data map[string]interface{}
-	resp.Diagnostics.Append(plan.Data.Unmarshal(&data)...)
+	data, diags := blockDataForAPI(ctx, blockDocumentClient, plan.Data)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Secrets left as the masked placeholder in the configuration are
+	// kept as they are on the server, so only the changed values need
+	// to be supplied. Non-secret fields are sent as configured.
+	current, err := blockDocumentClient.Get(ctx, blockID)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Block", "get", err))
+
+		return
+	}
+	data, _ = helpers.RestoreMaskedSecretValues(current.Data, data, blockSecretFields(latestBlockSchema)).(map[string]interface{})
+
 	err = blockDocumentClient.Update(ctx, blockID, api.BlockDocumentUpdate{
 		BlockSchemaID: latestBlockSchema.ID,
 		Data:          data,
@@ -412,7 +667,7 @@ func (r *BlockResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	diags := copyBlockToModel(block, &plan)
+	diags = copyBlockToModel(block, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
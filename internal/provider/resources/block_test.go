@@ -8,8 +8,10 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
 )
 
@@ -27,6 +29,86 @@ resource "prefect_block" "%s" {
 }`, workspace, blockName, blockName, blockValue, workspaceName, workspaceName)
 }
 
+func fixtureAccAnonymousBlock(workspace, workspaceName, blockName, blockValue string) string {
+	return fmt.Sprintf(`
+%s
+resource "prefect_block" "%s" {
+	is_anonymous = true
+	type_slug = "secret"
+	data = jsonencode({
+		"value" = "%s"
+	})
+	workspace_id = prefect_workspace.%s.id
+	depends_on = [prefect_workspace.%s]
+}`, workspace, blockName, blockValue, workspaceName, workspaceName)
+}
+
+func fixtureAccBlockWithReference(workspace, workspaceName, credentialsName, bucketName string) string {
+	return fmt.Sprintf(`
+%s
+resource "prefect_block" "credentials" {
+	name = "%s"
+	type_slug = "aws-credentials"
+	data = jsonencode({
+		"region_name" = "us-east-1"
+	})
+	workspace_id = prefect_workspace.%s.id
+	depends_on = [prefect_workspace.%s]
+}
+
+resource "prefect_block" "bucket" {
+	name = "%s"
+	type_slug = "s3-bucket"
+	data = jsonencode({
+		"bucket_name" = "my-bucket"
+		"credentials" = { "$ref" : { "block_type_slug" : "aws-credentials", "block_document_name" : "%s" } }
+	})
+	workspace_id = prefect_workspace.%s.id
+	depends_on = [prefect_block.credentials]
+}`, workspace, credentialsName, workspaceName, workspaceName, bucketName, credentialsName, workspaceName)
+}
+
+func fixtureAccBlockWithRotatedReference(workspace, workspaceName, credentialsName, bucketName, secretAccessKey string) string {
+	return fmt.Sprintf(`
+%s
+resource "prefect_block" "credentials" {
+	name = "%s"
+	type_slug = "aws-credentials"
+	data = jsonencode({
+		"region_name" = "us-east-1"
+		"aws_access_key_id" = "AKIAEXAMPLE"
+		"aws_secret_access_key" = "%s"
+	})
+	workspace_id = prefect_workspace.%s.id
+	depends_on = [prefect_workspace.%s]
+}
+
+resource "prefect_block" "bucket" {
+	name = "%s"
+	type_slug = "s3-bucket"
+	data = jsonencode({
+		"bucket_name" = "my-bucket"
+		"credentials" = { "$ref" : { "block_document_id" : prefect_block.credentials.id } }
+	})
+	workspace_id = prefect_workspace.%s.id
+}`, workspace, credentialsName, secretAccessKey, workspaceName, workspaceName, bucketName, workspaceName)
+}
+
+func fixtureAccBlockWithSecret(workspace, workspaceName, blockName, accessKeyID, secretAccessKey string) string {
+	return fmt.Sprintf(`
+%s
+resource "prefect_block" "%s" {
+	name = "%s"
+	type_slug = "aws-credentials"
+	data = jsonencode({
+		"aws_access_key_id" = "%s"
+		"aws_secret_access_key" = "%s"
+	})
+	workspace_id = prefect_workspace.%s.id
+	depends_on = [prefect_workspace.%s]
+}`, workspace, blockName, blockName, accessKeyID, secretAccessKey, workspaceName, workspaceName)
+}
+
 //nolint:paralleltest // we use the resource.ParallelTest helper instead
 func TestAccResource_block(t *testing.T) {
 	randomName := testutils.NewRandomPrefixedString()
@@ -87,6 +169,253 @@ func TestAccResource_block(t *testing.T) {
 	})
 }
 
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_block_anonymous(t *testing.T) {
+	randomName := testutils.NewRandomPrefixedString()
+	randomValue := testutils.NewRandomPrefixedString()
+
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+
+	blockResourceName := fmt.Sprintf("prefect_block.%s", randomName)
+	workspaceResourceName := fmt.Sprintf("prefect_workspace.%s", workspaceName)
+
+	var blockDocument api.BlockDocument
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Check creation + existence of the anonymous block resource,
+			// which is named by the server
+			{
+				Config: fixtureAccAnonymousBlock(workspace, workspaceName, randomName, randomValue),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBlockExists(blockResourceName, workspaceResourceName, &blockDocument),
+					testAccCheckBlockIsAnonymous(&blockDocument),
+					resource.TestCheckResourceAttr(blockResourceName, "is_anonymous", "true"),
+					resource.TestCheckResourceAttrSet(blockResourceName, "name"),
+					resource.TestCheckResourceAttr(blockResourceName, "type_slug", "secret"),
+					resource.TestCheckResourceAttr(blockResourceName, "data", fmt.Sprintf(`{"value":%q}`, randomValue)),
+				),
+			},
+			// Import State checks - import by block_id,workspace_id (dynamic)
+			{
+				ImportState:       true,
+				ResourceName:      blockResourceName,
+				ImportStateIdFunc: getBlockImportStateID(blockResourceName, workspaceResourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_block_masked_secret(t *testing.T) {
+	randomName := testutils.NewRandomPrefixedString()
+
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+
+	blockResourceName := fmt.Sprintf("prefect_block.%s", randomName)
+	workspaceResourceName := fmt.Sprintf("prefect_workspace.%s", workspaceName)
+
+	var blockDocument api.BlockDocument
+	var workspaceID uuid.UUID
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccBlockWithSecret(workspace, workspaceName, randomName, "key-id-1", "secret-value"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBlockExists(blockResourceName, workspaceResourceName, &blockDocument),
+					testAccCheckBlockValues(&blockDocument, ExpectedBlockValues{
+						Name:     randomName,
+						TypeSlug: "aws-credentials",
+						Data:     `{"aws_access_key_id":"key-id-1","aws_secret_access_key":"secret-value"}`,
+					}),
+				),
+			},
+			{
+				// Check that a non-secret value can be updated without re-supplying the secret
+				Config: fixtureAccBlockWithSecret(workspace, workspaceName, randomName, "key-id-2", helpers.MaskedValue),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBlockExists(blockResourceName, workspaceResourceName, &blockDocument),
+					testAccCheckBlockValues(&blockDocument, ExpectedBlockValues{
+						Name:     randomName,
+						TypeSlug: "aws-credentials",
+						Data:     `{"aws_access_key_id":"key-id-2","aws_secret_access_key":"secret-value"}`,
+					}),
+					resource.TestCheckResourceAttr(blockResourceName, "data", fmt.Sprintf(`{"aws_access_key_id":"key-id-2","aws_secret_access_key":%q}`, helpers.MaskedValue)),
+				),
+			},
+			{
+				// Check that the masked placeholder doesn't show up as drift
+				Config: fixtureAccBlockWithSecret(workspace, workspaceName, randomName, "key-id-2", helpers.MaskedValue),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				// Check that a non-secret value that happens to equal the masked placeholder
+				// is sent as-is, rather than being treated as a secret to keep
+				Config: fixtureAccBlockWithSecret(workspace, workspaceName, randomName, helpers.MaskedValue, helpers.MaskedValue),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBlockExists(blockResourceName, workspaceResourceName, &blockDocument),
+					testAccCheckBlockValues(&blockDocument, ExpectedBlockValues{
+						Name:     randomName,
+						TypeSlug: "aws-credentials",
+						Data:     fmt.Sprintf(`{"aws_access_key_id":%q,"aws_secret_access_key":"secret-value"}`, helpers.MaskedValue),
+					}),
+					func(s *terraform.State) error {
+						workspaceID, _ = uuid.Parse(s.RootModule().Resources[workspaceResourceName].Primary.ID)
+
+						return nil
+					},
+				),
+			},
+			{
+				// Check that a non-secret value changed from the placeholder outside of Terraform shows up as drift
+				PreConfig: func() {
+					c, _ := testutils.NewTestClient()
+					blockDocumentClient, _ := c.BlockDocuments(uuid.Nil, workspaceID)
+					err := blockDocumentClient.Update(context.Background(), blockDocument.ID, api.BlockDocumentUpdate{
+						BlockSchemaID:     blockDocument.BlockSchemaID,
+						Data:              map[string]interface{}{"aws_access_key_id": "key-id-3"},
+						MergeExistingData: true,
+					})
+					if err != nil {
+						t.Fatalf("error updating block out of band: %s", err)
+					}
+				},
+				Config: fixtureAccBlockWithSecret(workspace, workspaceName, randomName, helpers.MaskedValue, helpers.MaskedValue),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(blockResourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+		},
+	})
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_block_reference(t *testing.T) {
+	credentialsName := testutils.NewRandomPrefixedString()
+	bucketName := testutils.NewRandomPrefixedString()
+
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+
+	bucketResourceName := "prefect_block.bucket"
+	workspaceResourceName := fmt.Sprintf("prefect_workspace.%s", workspaceName)
+
+	var blockDocument api.BlockDocument
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Check that a Block referencing another Block by name is created
+			{
+				Config: fixtureAccBlockWithReference(workspace, workspaceName, credentialsName, bucketName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBlockExists(bucketResourceName, workspaceResourceName, &blockDocument),
+					resource.TestCheckResourceAttr(bucketResourceName, "name", bucketName),
+					resource.TestCheckResourceAttr(bucketResourceName, "type_slug", "s3-bucket"),
+					resource.TestCheckResourceAttrSet(bucketResourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_block_reference_rotation(t *testing.T) {
+	credentialsName := testutils.NewRandomPrefixedString()
+	bucketName := testutils.NewRandomPrefixedString()
+
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+
+	credentialsResourceName := "prefect_block.credentials"
+	bucketResourceName := "prefect_block.bucket"
+	workspaceResourceName := fmt.Sprintf("prefect_workspace.%s", workspaceName)
+
+	var credentials, bucket api.BlockDocument
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check that the bucket references the credentials
+				Config: fixtureAccBlockWithRotatedReference(workspace, workspaceName, credentialsName, bucketName, "secret-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBlockExists(credentialsResourceName, workspaceResourceName, &credentials),
+					testAccCheckBlockExists(bucketResourceName, workspaceResourceName, &bucket),
+					testAccCheckBlockReference(&bucket, "credentials", &credentials),
+				),
+			},
+			{
+				// Check that rotating the secret is a single in-place update of the credentials,
+				// leaving the bucket unchanged and still referencing them
+				Config: fixtureAccBlockWithRotatedReference(workspace, workspaceName, credentialsName, bucketName, "secret-2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(credentialsResourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction(bucketResourceName, plancheck.ResourceActionNoop),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBlockExists(credentialsResourceName, workspaceResourceName, &credentials),
+					testAccCheckBlockExists(bucketResourceName, workspaceResourceName, &bucket),
+					testAccCheckBlockReference(&bucket, "credentials", &credentials),
+					func(_ *terraform.State) error {
+						nested, _ := bucket.Data["credentials"].(map[string]interface{})
+						if nested["aws_secret_access_key"] != "secret-2" {
+							return fmt.Errorf("expected the bucket to resolve the rotated secret, got %v", nested["aws_secret_access_key"])
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckBlockReference is a Custom Check Function that
+// verifies that a Block references another one under a field.
+func testAccCheckBlockReference(fetchedBlockDocument *api.BlockDocument, field string, referencedBlockDocument *api.BlockDocument) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		reference, ok := fetchedBlockDocument.BlockDocumentReferences[field]
+		if !ok {
+			return fmt.Errorf("Expected block %s to reference a block under %s", fetchedBlockDocument.ID, field)
+		}
+		if reference.BlockDocument.ID != referencedBlockDocument.ID {
+			return fmt.Errorf("Expected block %s to reference block %s under %s, got %s", fetchedBlockDocument.ID, referencedBlockDocument.ID, field, reference.BlockDocument.ID)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckBlockIsAnonymous is a Custom Check Function that
+// verifies that the API object was created as an anonymous block.
+func testAccCheckBlockIsAnonymous(fetchedBlockDocument *api.BlockDocument) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if !fetchedBlockDocument.IsAnonymous {
+			return fmt.Errorf("Expected block %s to be anonymous", fetchedBlockDocument.ID)
+		}
+
+		return nil
+	}
+}
+
 // testAccCheckBlockExists is a Custom Check Function that
 // verifies that the API object was created correctly.
 func testAccCheckBlockExists(blockResourceName string, workspaceResourceName string, blockDocument *api.BlockDocument) resource.TestCheckFunc {
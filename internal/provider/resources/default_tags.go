@@ -0,0 +1,75 @@
+package resources
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+// planTagsAll plans `tags_all` as the planned tags with the provider's
+// default tags merged in, so the merged list shows up in the plan while
+// `tags` stays as configured.
+func planTagsAll(ctx context.Context, tags types.List, defaultTags map[string]string, resp *resource.ModifyPlanResponse) {
+	if tags.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), types.ListUnknown(types.StringType))...)
+
+		return
+	}
+
+	values := []string{}
+	resp.Diagnostics.Append(tags.ElementsAs(ctx, &values, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), helpers.MergeDefaultTags(values, defaultTags))...)
+}
+
+// tagsFromAPI returns the `tags` and `tags_all` to store for the tags
+// returned by the API. `tags_all` holds all of them, while `tags` keeps
+// the given tags as long as they're all still set, and otherwise holds the
+// API's tags without the provider's default tags, e.g. when importing.
+func tagsFromAPI(ctx context.Context, apiTags []string, tags types.List, defaultTags map[string]string) (types.List, types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if apiTags == nil {
+		apiTags = []string{}
+	}
+
+	tagsAll, listDiags := types.ListValueFrom(ctx, types.StringType, apiTags)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return tags, tagsAll, diags
+	}
+
+	if !tags.IsNull() && !tags.IsUnknown() {
+		var values []string
+		diags.Append(tags.ElementsAs(ctx, &values, false)...)
+		if diags.HasError() {
+			return tags, tagsAll, diags
+		}
+
+		kept := true
+		for _, tag := range values {
+			if !slices.Contains(apiTags, tag) {
+				kept = false
+
+				break
+			}
+		}
+		if kept {
+			return tags, tagsAll, diags
+		}
+	}
+
+	tags, listDiags = types.ListValueFrom(ctx, types.StringType, helpers.RemoveDefaultTags(apiTags, defaultTags))
+	diags.Append(listDiags...)
+
+	return tags, tagsAll, diags
+}
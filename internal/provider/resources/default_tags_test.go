@@ -0,0 +1,59 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+func TestMergeDefaultTagsHelper(t *testing.T) {
+	t.Parallel()
+
+	defaultTags := map[string]string{"team": "data", "cost-center": "1234"}
+
+	cases := []struct {
+		tags []string
+		want []string
+	}{
+		{nil, []string{"cost-center:1234", "team:data"}},
+		{[]string{"etl"}, []string{"etl", "cost-center:1234", "team:data"}},
+		{[]string{"team:platform"}, []string{"team:platform", "cost-center:1234"}},
+		{[]string{"team", "cost-center:5678"}, []string{"team", "cost-center:5678"}},
+		{[]string{"etl", "team:data", "cost-center:1234"}, []string{"etl", "team:data", "cost-center:1234"}},
+	}
+
+	for _, c := range cases {
+		got := helpers.MergeDefaultTags(c.tags, defaultTags)
+		if fmt.Sprint(got) != fmt.Sprint(c.want) {
+			t.Fatalf("tags %v merged with the default tags should be %v, but got %v", c.tags, c.want, got)
+		}
+	}
+
+	if got := helpers.MergeDefaultTags([]string{"etl"}, nil); fmt.Sprint(got) != "[etl]" {
+		t.Fatalf("tags without default tags should be unchanged, but got %v", got)
+	}
+}
+
+func TestRemoveDefaultTagsHelper(t *testing.T) {
+	t.Parallel()
+
+	defaultTags := map[string]string{"team": "data", "cost-center": "1234"}
+
+	cases := []struct {
+		tags []string
+		want []string
+	}{
+		{nil, []string{}},
+		{[]string{"etl", "cost-center:1234", "team:data"}, []string{"etl"}},
+		{[]string{"team:platform", "cost-center:1234"}, []string{"team:platform"}},
+		{[]string{"team", "cost-center:5678"}, []string{"team", "cost-center:5678"}},
+	}
+
+	for _, c := range cases {
+		got := helpers.RemoveDefaultTags(c.tags, defaultTags)
+		if fmt.Sprint(got) != fmt.Sprint(c.want) {
+			t.Fatalf("tags %v without the default tags should be %v, but got %v", c.tags, c.want, got)
+		}
+	}
+}
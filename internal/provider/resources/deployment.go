@@ -4,10 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"os"
+	"reflect"
 	"strings"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -17,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
@@ -28,11 +33,14 @@ import (
 var (
 	_ = resource.ResourceWithConfigure(&DeploymentResource{})
 	_ = resource.ResourceWithImportState(&DeploymentResource{})
+	_ = resource.ResourceWithModifyPlan(&DeploymentResource{})
+	_ = resource.ResourceWithValidateConfig(&DeploymentResource{})
 )
 
 // DeploymentResource contains state for the resource.
 type DeploymentResource struct {
-	client api.PrefectClient
+	client   api.PrefectClient
+	settings helpers.ProviderSettings
 }
 
 // DeploymentResourceModel defines the Terraform resource model.
@@ -44,19 +52,85 @@ type DeploymentResourceModel struct {
 	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
 	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
 
+	ConcurrencyLimit       types.Int64           `tfsdk:"concurrency_limit"`
+	ConcurrencyOptions     types.Object          `tfsdk:"concurrency_options"`
 	Description            types.String          `tfsdk:"description"`
 	EnforceParameterSchema types.Bool            `tfsdk:"enforce_parameter_schema"`
 	Entrypoint             types.String          `tfsdk:"entrypoint"`
 	FlowID                 customtypes.UUIDValue `tfsdk:"flow_id"`
+	JobVariables           jsontypes.Normalized  `tfsdk:"job_variables"`
 	ManifestPath           types.String          `tfsdk:"manifest_path"`
 	Name                   types.String          `tfsdk:"name"`
 	Parameters             jsontypes.Normalized  `tfsdk:"parameters"`
+	ParametersAll          jsontypes.Normalized  `tfsdk:"parameters_all"`
+	SensitiveParameters    jsontypes.Normalized  `tfsdk:"sensitive_parameters"`
+	ParametersObject       types.Dynamic         `tfsdk:"parameters_object"`
+	MergeParameters        types.Bool            `tfsdk:"merge_parameters"`
+	ParameterSchema        jsontypes.Normalized  `tfsdk:"parameter_openapi_schema"`
+	ParameterSchemaSum     types.String          `tfsdk:"parameter_schema_checksum"`
+	InferParameterSchema   types.Bool            `tfsdk:"infer_parameter_schema"`
 	Path                   types.String          `tfsdk:"path"`
 	Paused                 types.Bool            `tfsdk:"paused"`
+	PullSteps              jsontypes.Normalized  `tfsdk:"pull_steps"`
 	Tags                   types.List            `tfsdk:"tags"`
+	TagsAll                types.List            `tfsdk:"tags_all"`
+	InheritFlowTags        types.Bool            `tfsdk:"inherit_flow_tags"`
+	ReplaceOnVersionChange types.Bool            `tfsdk:"replace_on_version_change"`
+	SkipDestroy            types.Bool            `tfsdk:"skip_destroy"`
 	Version                types.String          `tfsdk:"version"`
+	VersionInfo            types.Object          `tfsdk:"version_info"`
+	VersionInfoFromEnv     types.Bool            `tfsdk:"version_info_from_env"`
 	WorkPoolName           types.String          `tfsdk:"work_pool_name"`
 	WorkQueueName          types.String          `tfsdk:"work_queue_name"`
+	WorkQueueID            customtypes.UUIDValue `tfsdk:"work_queue_id"`
+
+	StorageDocumentID        customtypes.UUIDValue `tfsdk:"storage_document_id"`
+	InfrastructureDocumentID customtypes.UUIDValue `tfsdk:"infrastructure_document_id"`
+
+	UpdatedBy types.Object `tfsdk:"updated_by"`
+}
+
+// ConcurrencyOptionsModel defines the Terraform model for a deployment's concurrency options.
+type ConcurrencyOptionsModel struct {
+	CollisionStrategy types.String `tfsdk:"collision_strategy"`
+}
+
+var concurrencyOptionsAttrTypes = map[string]attr.Type{
+	"collision_strategy": types.StringType,
+}
+
+// VersionInfoModel defines the Terraform model for a deployment's version info.
+type VersionInfoModel struct {
+	Commit types.String `tfsdk:"commit"`
+	Branch types.String `tfsdk:"branch"`
+	URL    types.String `tfsdk:"url"`
+}
+
+var versionInfoAttrTypes = map[string]attr.Type{
+	"commit": types.StringType,
+	"branch": types.StringType,
+	"url":    types.StringType,
+}
+
+// UpdatedByModel defines the Terraform model for the actor that last updated a deployment.
+type UpdatedByModel struct {
+	ID           customtypes.UUIDValue `tfsdk:"id"`
+	Type         types.String          `tfsdk:"type"`
+	DisplayValue types.String          `tfsdk:"display_value"`
+}
+
+var updatedByAttrTypes = map[string]attr.Type{
+	"id":            customtypes.UUIDType{},
+	"type":          types.StringType,
+	"display_value": types.StringType,
+}
+
+// versionInfoEnvVars lists, for each version_info field, the environment
+// variables read when version_info_from_env is set. The first one set wins.
+var versionInfoEnvVars = map[string][]string{
+	"commit": {"GIT_COMMIT", "GITHUB_SHA", "CI_COMMIT_SHA"},
+	"branch": {"GIT_BRANCH", "GITHUB_REF_NAME", "CI_COMMIT_REF_NAME"},
+	"url":    {"GIT_URL", "CI_PROJECT_URL"},
 }
 
 // NewDeploymentResource returns a new DeploymentResource.
@@ -77,17 +151,18 @@ func (r *DeploymentResource) Configure(_ context.Context, req resource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(api.PrefectClient)
+	data, ok := req.ProviderData.(*helpers.ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected api.PrefectClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *helpers.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.settings = data.Settings
 }
 
 // Schema defines the schema for the resource.
@@ -124,6 +199,26 @@ func (r *DeploymentResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				CustomType:  customtypes.TimestampType{},
 				Description: "Timestamp of when the resource was updated (RFC3339)",
 			},
+			"updated_by": schema.SingleNestedAttribute{
+				Computed: true,
+				Description: "The actor that last updated the deployment, e.g. to detect changes made outside of Terraform. " +
+					"Only reported by Prefect Cloud.",
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:    true,
+						CustomType:  customtypes.UUIDType{},
+						Description: "ID (UUID) of the actor",
+					},
+					"type": schema.StringAttribute{
+						Computed:    true,
+						Description: "Type of the actor, e.g. `USER` or `BOT`",
+					},
+					"display_value": schema.StringAttribute{
+						Computed:    true,
+						Description: "Display name of the actor",
+					},
+				},
+			},
 			"account_id": schema.StringAttribute{
 				CustomType:  customtypes.UUIDType{},
 				Description: "Account ID (UUID), defaults to the account set in the provider",
@@ -144,16 +239,15 @@ func (r *DeploymentResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Required:    true,
 			},
 			"paused": schema.BoolAttribute{
-				Description: "Whether or not the deployment is paused.",
-				Optional:    true,
-				Computed:    true,
-				Default:     booldefault.StaticBool(false),
+				Description: "Whether or not the deployment is paused. Defaults to the provider's `default_paused_by_workspace` value for the deployment's workspace, or `false`. " +
+					"Changes are applied through the API's pause and resume endpoints.",
+				Optional: true,
+				Computed: true,
 			},
 			"enforce_parameter_schema": schema.BoolAttribute{
-				Description: "Whether or not the deployment should enforce the parameter schema.",
+				Description: "Whether or not the deployment should enforce the parameter schema. Defaults to `false`, or to `true` when `parameter_openapi_schema` is set and the provider's `enforce_parameter_schema_when_provided` is enabled. When enforced, `parameters` are also validated against the schema at plan time, including nested objects and parameters the schema doesn't define.",
 				Optional:    true,
 				Computed:    true,
-				Default:     booldefault.StaticBool(false),
 			},
 			"manifest_path": schema.StringAttribute{
 				Description: "The path to the flow's manifest file, relative to the chosen storage.",
@@ -164,13 +258,28 @@ func (r *DeploymentResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"work_queue_name": schema.StringAttribute{
-				Description: "The work queue for the deployment. If no work queue is set, work will not be scheduled.",
+				Description: "The work queue for the deployment. If no work queue is set, work will not be scheduled. If a work pool is set without a work queue, this resolves to the work pool's default queue, including when the work pool changes.",
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"work_queue_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "ID (UUID) of the work queue resolved from `work_pool_name` and `work_queue_name`.",
+				Computed:    true,
+			},
+			"storage_document_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "ID (UUID) of the storage Block the deployment's flow code is loaded from, as used by older deployments, e.g. the `id` of a `prefect_block`. Leave unset to clear it.",
+				Optional:    true,
+			},
+			"infrastructure_document_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "ID (UUID) of the infrastructure Block the deployment's flow runs are executed on, as used by older deployments, e.g. the `id` of a `prefect_block`. Leave unset to clear it.",
+				Optional:    true,
+			},
 			"work_pool_name": schema.StringAttribute{
 				Description: "The name of the deployment's work pool.",
 				Optional:    true,
@@ -201,8 +310,59 @@ func (r *DeploymentResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIf(
+						requiresReplaceOnVersionChange,
+						"If replace_on_version_change is true, changing the version will force the deployment to be replaced.",
+						"If `replace_on_version_change` is true, changing the `version` will force the deployment to be replaced.",
+					),
+				},
+			},
+			"version_info": schema.SingleNestedAttribute{
+				Description: "Git provenance of the deployment's version.",
+				Optional:    true,
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"commit": schema.StringAttribute{
+						Description: "The commit SHA.",
+						Optional:    true,
+						Computed:    true,
+					},
+					"branch": schema.StringAttribute{
+						Description: "The branch name.",
+						Optional:    true,
+						Computed:    true,
+					},
+					"url": schema.StringAttribute{
+						Description: "The URL of the repository.",
+						Optional:    true,
+						Computed:    true,
+					},
 				},
 			},
+			"version_info_from_env": schema.BoolAttribute{
+				Description: "Whether to fill in any `version_info` values not set explicitly from environment variables commonly set in CI. " +
+					"`commit` is read from `GIT_COMMIT`, `GITHUB_SHA` or `CI_COMMIT_SHA`, " +
+					"`branch` from `GIT_BRANCH`, `GITHUB_REF_NAME` or `CI_COMMIT_REF_NAME`, " +
+					"and `url` from `GIT_URL` or `CI_PROJECT_URL` (the first one set is used).",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"replace_on_version_change": schema.BoolAttribute{
+				Description: "Whether a change to `version` should replace the deployment (creating a new deployment ID) instead of updating it in place.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"skip_destroy": schema.BoolAttribute{
+				Description: "Whether destroying the resource only removes the deployment from the Terraform state, leaving it in Prefect, " +
+					"e.g. for deployments shared with other teams. The deployment is then orphaned: Terraform no longer manages it, " +
+					"and it keeps scheduling flow runs until it is deleted outside of Terraform. " +
+					"This also applies when the deployment is replaced, so the old deployment is kept alongside the new one.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 			"entrypoint": schema.StringAttribute{
 				Description: "The path to the entrypoint for the workflow, relative to the path.",
 				Optional:    true,
@@ -212,24 +372,143 @@ func (r *DeploymentResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"tags": schema.ListAttribute{
-				Description: "Tags associated with the deployment",
+				Description: "Tags associated with the deployment. The provider's `default_tags` are merged into `tags_all`.",
 				ElementType: types.StringType,
 				Optional:    true,
 				Computed:    true,
 				Default:     listdefault.StaticValue(defaultEmptyTagList),
 			},
+			"tags_all": schema.ListAttribute{
+				Description: "All tags of the deployment, i.e. `tags` with the flow's tags, when `inherit_flow_tags` is set, and the provider's `default_tags` merged in.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"inherit_flow_tags": schema.BoolAttribute{
+				Description: "Whether the flow's tags should be merged into the deployment's tags. The merged, de-duplicated list is stored in `tags_all`, while `tags` stays as configured.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"parameter_openapi_schema": schema.StringAttribute{
+				Description: "The OpenAPI schema of the flow's parameters, as a JSON string. Set by `prefect deploy` from the flow's signature when not set here, or inferred from `parameters` when `infer_parameter_schema` is set.",
+				Optional:    true,
+				Computed:    true,
+				CustomType:  jsontypes.NormalizedType{},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"infer_parameter_schema": schema.BoolAttribute{
+				Description: "Whether to infer `parameter_openapi_schema` from `parameters` when it isn't set, and enforce it unless `enforce_parameter_schema` is set. " +
+					"This is a best-effort inference: each parameter is typed after its configured value (e.g. `string` or `integer`), " +
+					"nested values aren't described any further, and all parameters are optional.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"parameter_schema_checksum": schema.StringAttribute{
+				Description: "SHA-256 checksum of the deployment's parameter schema (as canonical JSON), which changes only when the schema itself does, e.g. to detect schema changes when `enforce_parameter_schema` is set.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"parameters": schema.StringAttribute{
 				Description: "Parameters for flow runs scheduled by the deployment.",
 				Optional:    true,
 				Computed:    true,
 				CustomType:  jsontypes.NormalizedType{},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("parameters_object")),
+				},
+			},
+			"sensitive_parameters": schema.StringAttribute{
+				Description: "Parameters for flow runs scheduled by the deployment whose values are secret, as a JSON string. " +
+					"They're sent along with `parameters`, but kept out of it, so their values are redacted in plans and state. " +
+					"Parameters that `parameter_openapi_schema` marks as secret (`writeOnly`, or with a `password` format, e.g. Pydantic's `SecretStr`) " +
+					"and that aren't set in `parameters` are read into this attribute, e.g. when set by `prefect deploy` or on import.",
+				Optional:   true,
+				Computed:   true,
+				Sensitive:  true,
+				CustomType: jsontypes.NormalizedType{},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"parameters_object": schema.DynamicAttribute{
+				Description: "Parameters for flow runs scheduled by the deployment, as a native HCL object rather than a JSON string. " +
+					"The object is serialized to JSON and sent as `parameters`, which reflects the result. Conflicts with `parameters`.",
+				Optional: true,
+			},
+			"merge_parameters": schema.BoolAttribute{
+				Description: "Whether `parameters` are merged into the deployment's existing parameters, rather than replacing them. " +
+					"When set, parameters added outside of Terraform (e.g. by `prefect deploy`) are kept, and only show up in `parameters_all`; " +
+					"otherwise they show up as drift in `parameters` and are removed on the next apply. " +
+					"Note that in merge mode, removing a parameter from the configuration doesn't remove it from the deployment.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"parameters_all": schema.StringAttribute{
+				Description: "All parameters of the deployment, as a JSON string, including the ones set outside of Terraform that `merge_parameters` keeps. " +
+					"Sensitive parameters are left out, as in `parameters`.",
+				Computed:   true,
+				CustomType: jsontypes.NormalizedType{},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"job_variables": schema.StringAttribute{
+				Description: "Overrides for the work pool's base job template variables (e.g. `image`, `env`, `cpu`), as a JSON string. Formerly known as `infra_overrides`.",
+				Optional:    true,
+				Computed:    true,
+				CustomType:  jsontypes.NormalizedType{},
+			},
+			"concurrency_limit": schema.Int64Attribute{
+				Description: "The maximum number of concurrent runs of the deployment. Leave unset for no limit. A limit above the work pool's concurrency limit has no effect, and is flagged with a warning when planning. Requires Prefect 3.1.0 or later on self-hosted servers.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"concurrency_options": schema.SingleNestedAttribute{
+				Description: "How runs beyond the `concurrency_limit` are handled. Can only be set along with `concurrency_limit`. Requires Prefect 3.1.0 or later on self-hosted servers.",
+				Optional:    true,
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"collision_strategy": schema.StringAttribute{
+						Description: "Whether runs beyond the limit are queued (`ENQUEUE`) or cancelled (`CANCEL_NEW`).",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("ENQUEUE", "CANCEL_NEW"),
+						},
+					},
+				},
+			},
+			"pull_steps": schema.StringAttribute{
+				Description: "Steps describing how the flow code is retrieved (e.g. `prefect.deployments.steps.git_clone`), as a JSON-encoded list of step objects.",
+				Optional:    true,
+				Computed:    true,
+				CustomType:  jsontypes.NormalizedType{},
 			},
 		},
 	}
 }
 
+// requiresReplaceOnVersionChange marks a version change as requiring
+// replacement when the deployment has opted in via replace_on_version_change.
+func requiresReplaceOnVersionChange(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	var replaceOnVersionChange types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("replace_on_version_change"), &replaceOnVersionChange)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.RequiresReplace = replaceOnVersionChange.ValueBool()
+}
+
 // copyDeploymentToModel copies an api.Deployment to a DeploymentResourceModel.
-func copyDeploymentToModel(ctx context.Context, deployment *api.Deployment, model *DeploymentResourceModel) diag.Diagnostics {
+func copyDeploymentToModel(ctx context.Context, deployment *api.Deployment, model *DeploymentResourceModel, defaultTags map[string]string) diag.Diagnostics {
 	model.ID = types.StringValue(deployment.ID.String())
 	model.Created = customtypes.NewTimestampPointerValue(deployment.Created)
 	model.Updated = customtypes.NewTimestampPointerValue(deployment.Updated)
@@ -245,230 +524,1445 @@ func copyDeploymentToModel(ctx context.Context, deployment *api.Deployment, mode
 	model.Version = types.StringValue(deployment.Version)
 	model.WorkPoolName = types.StringValue(deployment.WorkPoolName)
 	model.WorkQueueName = types.StringValue(deployment.WorkQueueName)
+	model.WorkQueueID = customtypes.NewUUIDPointerValue(deployment.WorkQueueID)
+	model.StorageDocumentID = customtypes.NewUUIDPointerValue(deployment.StorageDocumentID)
+	model.InfrastructureDocumentID = customtypes.NewUUIDPointerValue(deployment.InfrastructureDocumentID)
+
+	model.ConcurrencyLimit = types.Int64PointerValue(deployment.ConcurrencyLimit)
+
+	// An unset schema is treated as an empty one, so the checksum is always known.
+	parameterSchema := deployment.ParameterOpenAPISchema
+	if parameterSchema == nil {
+		parameterSchema = map[string]interface{}{}
+	}
+	checksum, err := helpers.JSONChecksum(parameterSchema)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.Append(helpers.SerializeDataErrorDiagnostic("parameter_schema_checksum", "Deployment parameter schema", err))
+
+		return diags
+	}
+	model.ParameterSchemaSum = types.StringValue(checksum)
+
+	// The configured schema is kept when the API only filled in its defaults.
+	if !parameterSchemaMatches(model.ParameterSchema, parameterSchema) {
+		byteSlice, err := json.Marshal(parameterSchema)
+		if err != nil {
+			var diags diag.Diagnostics
+			diags.Append(helpers.SerializeDataErrorDiagnostic("parameter_openapi_schema", "Deployment parameter schema", err))
 
-	tags, diags := types.ListValueFrom(ctx, types.StringType, deployment.Tags)
+			return diags
+		}
+		model.ParameterSchema = jsontypes.NewNormalizedValue(string(byteSlice))
+	}
+
+	tags, tagsAll, diags := tagsFromAPI(ctx, deployment.Tags, model.Tags, defaultTags)
 	if diags.HasError() {
 		return diags
 	}
 	model.Tags = tags
+	model.TagsAll = tagsAll
+
+	model.ConcurrencyOptions = types.ObjectNull(concurrencyOptionsAttrTypes)
+	if deployment.ConcurrencyOptions != nil {
+		model.ConcurrencyOptions, diags = types.ObjectValueFrom(ctx, concurrencyOptionsAttrTypes, ConcurrencyOptionsModel{
+			CollisionStrategy: types.StringValue(deployment.ConcurrencyOptions.CollisionStrategy),
+		})
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	model.UpdatedBy = types.ObjectNull(updatedByAttrTypes)
+	if deployment.UpdatedBy != nil {
+		model.UpdatedBy, diags = types.ObjectValueFrom(ctx, updatedByAttrTypes, UpdatedByModel{
+			ID:           customtypes.NewUUIDPointerValue(deployment.UpdatedBy.ID),
+			Type:         types.StringValue(deployment.UpdatedBy.Type),
+			DisplayValue: types.StringValue(deployment.UpdatedBy.DisplayValue),
+		})
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	model.VersionInfo = types.ObjectNull(versionInfoAttrTypes)
+	if deployment.VersionInfo != nil {
+		model.VersionInfo, diags = types.ObjectValueFrom(ctx, versionInfoAttrTypes, VersionInfoModel{
+			Commit: types.StringValue(deployment.VersionInfo.Version),
+			Branch: types.StringValue(deployment.VersionInfo.Branch),
+			URL:    types.StringValue(deployment.VersionInfo.URL),
+		})
+		if diags.HasError() {
+			return diags
+		}
+	}
 
 	return nil
 }
 
-// Create creates the resource and sets the initial Terraform state.
-func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan DeploymentResourceModel
+// versionInfoFromModel converts the version_info attribute into its
+// API representation, returning nil when it is not set.
+func versionInfoFromModel(ctx context.Context, versionInfo types.Object) (*api.VersionInfo, diag.Diagnostics) {
+	if versionInfo.IsNull() || versionInfo.IsUnknown() {
+		return nil, nil
+	}
 
-	// Populate the model from resource configuration and emit diagnostics on error
-	resp.Diagnostics.Append(req.Config.Get(ctx, &plan)...)
-	if resp.Diagnostics.HasError() {
-		return
+	var model VersionInfoModel
+	diags := versionInfo.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
 	}
 
-	client, err := r.client.Deployments(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating deployment client",
-			fmt.Sprintf("Could not create deployment client, unexpected error: %s. This is a bug in the provider, please report this to the maintainers.", err.Error()),
-		)
+	return &api.VersionInfo{
+		Type:    "vcs:git",
+		Version: model.Commit.ValueString(),
+		Branch:  model.Branch.ValueString(),
+		URL:     model.URL.ValueString(),
+	}, nil
+}
+
+// versionInfoFromEnv fills in any version_info values that are not set
+// in the configuration from the environment. Explicit values always win.
+func versionInfoFromEnv(ctx context.Context, configured types.Object) (types.Object, diag.Diagnostics) {
+	var model VersionInfoModel
+	if !configured.IsNull() && !configured.IsUnknown() {
+		diags := configured.As(ctx, &model, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			return types.ObjectNull(versionInfoAttrTypes), diags
+		}
 	}
 
-	var tags []string
-	resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &tags, false)...)
-	if resp.Diagnostics.HasError() {
-		return
+	fromEnv := func(value types.String, field string) types.String {
+		if !value.IsNull() {
+			return value
+		}
+
+		for _, name := range versionInfoEnvVars[field] {
+			if envValue := os.Getenv(name); envValue != "" {
+				return types.StringValue(envValue)
+			}
+		}
+
+		return types.StringValue("")
 	}
 
-	var data map[string]interface{}
-	resp.Diagnostics.Append(plan.Parameters.Unmarshal(&data)...)
-	if resp.Diagnostics.HasError() {
-		return
+	model.Commit = fromEnv(model.Commit, "commit")
+	model.Branch = fromEnv(model.Branch, "branch")
+	model.URL = fromEnv(model.URL, "url")
+
+	return types.ObjectValueFrom(ctx, versionInfoAttrTypes, model)
+}
+
+// concurrencyOptionsFromModel converts the concurrency_options attribute
+// into its API representation, returning nil when it is not set.
+func concurrencyOptionsFromModel(ctx context.Context, options types.Object) (*api.ConcurrencyOptions, diag.Diagnostics) {
+	if options.IsNull() || options.IsUnknown() {
+		return nil, nil
 	}
 
-	deployment, err := client.Create(ctx, api.DeploymentCreate{
-		Description:            plan.Description.ValueString(),
-		EnforceParameterSchema: plan.EnforceParameterSchema.ValueBool(),
-		Entrypoint:             plan.Entrypoint.ValueString(),
-		FlowID:                 plan.FlowID.ValueUUID(),
-		ManifestPath:           plan.ManifestPath.ValueString(),
-		Name:                   plan.Name.ValueString(),
-		Parameters:             data,
-		Path:                   plan.Path.ValueString(),
-		Paused:                 plan.Paused.ValueBool(),
-		Tags:                   tags,
-		Version:                plan.Version.ValueString(),
-		WorkPoolName:           plan.WorkPoolName.ValueString(),
-		WorkQueueName:          plan.WorkQueueName.ValueString(),
-	})
+	var model ConcurrencyOptionsModel
+	diags := options.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &api.ConcurrencyOptions{
+		CollisionStrategy: model.CollisionStrategy.ValueString(),
+	}, nil
+}
+
+// mergeFlowTags appends the tags of the given flow to the deployment's tags,
+// skipping any that are already present.
+func mergeFlowTags(ctx context.Context, client api.PrefectClient, model *DeploymentResourceModel, tags []string) ([]string, error) {
+	flowsClient, err := client.Flows(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating deployment",
-			fmt.Sprintf("Could not create deployment, unexpected error: %s", err),
-		)
+		return nil, fmt.Errorf("failed to create flow client: %w", err)
+	}
 
-		return
+	flow, err := flowsClient.Get(ctx, model.FlowID.ValueUUID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get flow %s: %w", model.FlowID.ValueString(), err)
 	}
 
-	resp.Diagnostics.Append(copyDeploymentToModel(ctx, deployment, &plan)...)
+	candidates := make([]string, 0, len(tags)+len(flow.Tags))
+	candidates = append(candidates, tags...)
+	candidates = append(candidates, flow.Tags...)
+
+	merged := make([]string, 0, len(candidates))
+	seen := make(map[string]bool, len(candidates))
+	for _, tag := range candidates {
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		merged = append(merged, tag)
+	}
+
+	return merged, nil
+}
+
+// ValidateConfig ensures that concurrency_options is only set along with concurrency_limit.
+func (r *DeploymentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config DeploymentResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
-	if resp.Diagnostics.HasError() {
+	if config.ConcurrencyLimit.IsUnknown() || config.ConcurrencyOptions.IsUnknown() {
 		return
 	}
+
+	if config.ConcurrencyLimit.IsNull() && !config.ConcurrencyOptions.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("concurrency_options"),
+			"Missing concurrency_limit",
+			"The `concurrency_options` attribute can only be set when `concurrency_limit` is also set.",
+		)
+	}
 }
 
-// Read refreshes the Terraform state with the latest data.
-func (r *DeploymentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var model DeploymentResourceModel
+// ModifyPlan adjusts the plan for values that depend on other objects.
+//
+// When version_info_from_env is set, any version_info values not set in the
+// configuration are read from the environment, so they show up in the plan.
+//
+// Of the server-assigned attributes, `created` never changes, and `updated`
+// is expected to change on every update, so both are left to their schema
+// plan modifiers. work_queue_id is kept stable here unless the pool or queue changes.
+//
+// When inherit_flow_tags is set, the flow's tags are merged into the planned
+// tags, so the merged list shows up in the plan rather than as drift.
+// The provider's default_tags are merged in after them.
+//
+// When parameters_object is set, it's serialized to JSON and planned as
+// `parameters`, so the rest of the resource only deals with the JSON form.
+//
+// When the parameter schema is enforced, the configured parameters are
+// validated against it, including nested objects.
+//
+// concurrency_limit and concurrency_options are rejected when the server's
+// version predates deployment concurrency, as it would ignore them.
+//
+// parameters_all is only planned to change along with the parameters, so
+// parameters kept by merge_parameters don't show up as a change.
+//
+// work_queue_name uses UseStateForUnknown, so without this the old queue name
+// would be carried over to the new pool. When the pool is set or changes and
+// the queue isn't configured, we plan the pool's default queue, or mark the
+// queue as unknown so it's populated by the API.
+func (r *DeploymentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to reconcile on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
 
-	// Populate the model from state and emit diagnostics on error
-	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	var plan, config DeploymentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	client, err := r.client.Deployments(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating deployment client",
-			fmt.Sprintf("Could not create deployment client, unexpected error: %s. This is a bug in the provider, please report this to the maintainers.", err.Error()),
-		)
-	}
+	if !config.ParametersObject.IsNull() {
+		parameters := jsontypes.NewNormalizedUnknown()
 
-	// A deployment can be imported + read by either ID or Handle
-	// If both are set, we prefer the ID
-	var deployment *api.Deployment
-	if !model.ID.IsNull() {
-		var deploymentID uuid.UUID
-		deploymentID, err = uuid.Parse(model.ID.ValueString())
+		encoded, known, err := helpers.DynamicToJSON(ctx, config.ParametersObject)
 		if err != nil {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("id"),
-				"Error parsing Deployment ID",
-				fmt.Sprintf("Could not parse deployment ID to UUID, unexpected error: %s", err.Error()),
-			)
+			resp.Diagnostics.Append(helpers.SerializeDataErrorDiagnostic("parameters_object", "Deployment parameters", err))
 
 			return
 		}
+		if known {
+			parameters = jsontypes.NewNormalizedValue(encoded)
+		}
 
-		deployment, err = client.Get(ctx, deploymentID)
+		config.Parameters = parameters
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("parameters"), parameters)...)
 	}
 
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error refreshing deployment state",
-			fmt.Sprintf("Could not read Deployment, unexpected error: %s", err.Error()),
-		)
+	// The flow's tags and the provider's default tags are merged into
+	// tags_all, while tags stays as configured.
+	if r.client != nil {
+		tags := plan.Tags
+		if plan.InheritFlowTags.ValueBool() && !plan.Tags.IsUnknown() {
+			if plan.FlowID.IsUnknown() {
+				tags = types.ListUnknown(types.StringType)
+			} else {
+				var values []string
+				resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &values, false)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				merged, err := mergeFlowTags(ctx, r.client, &plan, values)
+				if err != nil {
+					resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Flow", "get", err))
+
+					return
+				}
+
+				var diags diag.Diagnostics
+				tags, diags = types.ListValueFrom(ctx, types.StringType, merged)
+				resp.Diagnostics.Append(diags...)
+			}
+		}
 
-		return
+		planTagsAll(ctx, tags, r.settings.DefaultTags, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	resp.Diagnostics.Append(copyDeploymentToModel(ctx, deployment, &model)...)
-	if resp.Diagnostics.HasError() {
-		return
+	if plan.VersionInfoFromEnv.ValueBool() && !config.VersionInfo.IsUnknown() {
+		versionInfo, diags := versionInfoFromEnv(ctx, config.VersionInfo)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("version_info"), versionInfo)...)
 	}
 
-	byteSlice, err := json.Marshal(deployment.Parameters)
-	if err != nil {
-		resp.Diagnostics.Append(helpers.SerializeDataErrorDiagnostic("parameters", "Deployment parameters", err))
+	// An inferred parameter schema is enforced, unless enforce_parameter_schema
+	// says otherwise.
+	inferredParameterSchema := plan.InferParameterSchema.ValueBool() && config.ParameterSchema.IsNull()
+	if inferredParameterSchema {
+		parameterSchema := jsontypes.NewNormalizedUnknown()
+		if !config.Parameters.IsUnknown() {
+			var diags diag.Diagnostics
+			parameterSchema, diags = inferParameterSchema(config.Parameters)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		plan.ParameterSchema = parameterSchema
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("parameter_openapi_schema"), parameterSchema)...)
+
+		if config.EnforceParameterSchema.IsNull() {
+			config.EnforceParameterSchema = types.BoolValue(true)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("enforce_parameter_schema"), true)...)
+		}
 	}
-	model.Parameters = jsontypes.NewNormalizedValue(string(byteSlice))
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	// An unset paused follows the provider's default for the workspace,
+	// which can only be resolved once the workspace is known.
+	// An unset enforce_parameter_schema follows the provider's default
+	// for deployments that provide a parameter schema.
+	enforceParameterSchema := config.EnforceParameterSchema.ValueBool()
+	if (config.Paused.IsNull() || config.EnforceParameterSchema.IsNull()) && !plan.WorkspaceID.IsUnknown() && r.client != nil {
+		if config.Paused.IsNull() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("paused"), r.settings.DefaultPaused(plan.WorkspaceID.ValueUUID()))...)
+		}
+
+		if config.EnforceParameterSchema.IsNull() {
+			enforceParameterSchema = defaultEnforceParameterSchema(r.settings, &config)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("enforce_parameter_schema"), enforceParameterSchema)...)
+		}
+	}
+
+	warnSecretParametersInPlainText(&plan, &config, resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-}
 
-// Update updates the resource and sets the updated Terraform state on success.
-func (r *DeploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var model DeploymentResourceModel
+	if enforceParameterSchema {
+		validateParametersAgainstSchema(&plan, &config, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	r.errorOnUnsupportedConcurrency(ctx, &config, resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	client, err := r.client.Deployments(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
-	if err != nil {
+	r.warnOnIneffectiveConcurrencyLimit(ctx, &plan, resp)
+	warnOnUnschedulableDeployment(&plan, &config, resp)
+
+	// Nothing else to reconcile on create, other than resolving the
+	// work pool's default queue when no queue is configured.
+	if req.State.Raw.IsNull() {
+		if config.WorkQueueName.IsNull() {
+			r.planDefaultWorkQueue(ctx, &plan, resp)
+		}
+
+		return
+	}
+
+	var state DeploymentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// parameters may have been serialized from parameters_object above, so
+	// we'll compare the planned value rather than plan.Parameters.
+	var parameters jsontypes.Normalized
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("parameters"), &parameters)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !parameters.Equal(state.Parameters) || !plan.SensitiveParameters.Equal(state.SensitiveParameters) || !plan.MergeParameters.Equal(state.MergeParameters) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("parameters_all"), jsontypes.NewNormalizedUnknown())...)
+	}
+
+	// An unset version_info isn't sent to the API, so the value in state stays current.
+	if !plan.VersionInfoFromEnv.ValueBool() && config.VersionInfo.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("version_info"), state.VersionInfo)...)
+	}
+
+	// parameter_schema_checksum is kept from state, unless the planned
+	// parameter schema differs from the one in state.
+	schemaUnchanged := plan.ParameterSchema.Equal(state.ParameterSchema)
+	if !schemaUnchanged && !plan.ParameterSchema.IsUnknown() && !plan.ParameterSchema.IsNull() && !state.ParameterSchema.IsNull() {
+		var diags diag.Diagnostics
+		schemaUnchanged, diags = plan.ParameterSchema.StringSemanticEquals(ctx, state.ParameterSchema)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if !schemaUnchanged {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("parameter_schema_checksum"), types.StringUnknown())...)
+	}
+
+	// work_queue_id only changes when the pool or queue does, so we'll keep
+	// the resolved ID from state otherwise, rather than showing it as
+	// "known after apply" on every unrelated update.
+	if plan.WorkPoolName.Equal(state.WorkPoolName) && plan.WorkQueueName.Equal(state.WorkQueueName) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("work_queue_id"), state.WorkQueueID)...)
+	}
+
+	if plan.WorkPoolName.IsUnknown() || plan.WorkPoolName.Equal(state.WorkPoolName) {
+		return
+	}
+
+	if config.WorkQueueName.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("work_queue_name"), types.StringUnknown())...)
+		r.planDefaultWorkQueue(ctx, &plan, resp)
+	}
+}
+
+// planDefaultWorkQueue sets the planned work_queue_name and work_queue_id
+// to the work pool's default queue, which the API uses when no queue is set.
+//
+// The work pool may not exist yet (e.g. when it's created in the same apply),
+// so we'll leave both to be populated by the API if it can't be fetched.
+func (r *DeploymentResource) planDefaultWorkQueue(ctx context.Context, plan *DeploymentResourceModel, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || plan.WorkPoolName.IsUnknown() || plan.WorkPoolName.ValueString() == "" ||
+		plan.AccountID.IsUnknown() || plan.WorkspaceID.IsUnknown() {
+		return
+	}
+
+	client, err := r.client.WorkPools(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
+	if err != nil {
+		return
+	}
+
+	pool, err := client.Get(ctx, plan.WorkPoolName.ValueString())
+	if err != nil {
+		return
+	}
+
+	queues, err := client.ListQueues(ctx, pool.Name)
+	if err != nil {
+		return
+	}
+
+	for _, queue := range queues {
+		if queue.ID == pool.DefaultQueueID {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("work_queue_name"), queue.Name)...)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("work_queue_id"), customtypes.NewUUIDValue(queue.ID))...)
+
+			return
+		}
+	}
+}
+
+// warnOnIneffectiveConcurrencyLimit adds a warning when the deployment's
+// concurrency limit exceeds its work pool's, since the pool's limit caps
+// the deployment's runs anyway.
+//
+// The work pool may not exist yet (e.g. when it's created in the same apply),
+// so we'll skip the check if it can't be fetched.
+func (r *DeploymentResource) warnOnIneffectiveConcurrencyLimit(ctx context.Context, plan *DeploymentResourceModel, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || plan.ConcurrencyLimit.IsUnknown() || plan.ConcurrencyLimit.IsNull() {
+		return
+	}
+
+	if plan.WorkPoolName.IsUnknown() || plan.WorkPoolName.ValueString() == "" ||
+		plan.AccountID.IsUnknown() || plan.WorkspaceID.IsUnknown() {
+		return
+	}
+
+	client, err := r.client.WorkPools(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
+	if err != nil {
+		return
+	}
+
+	pool, err := client.Get(ctx, plan.WorkPoolName.ValueString())
+	if err != nil || pool.ConcurrencyLimit == nil {
+		return
+	}
+
+	if plan.ConcurrencyLimit.ValueInt64() > *pool.ConcurrencyLimit {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("concurrency_limit"),
+			"Deployment concurrency limit exceeds work pool limit",
+			fmt.Sprintf("The deployment's concurrency_limit (%d) is higher than the concurrency limit of work pool %q (%d), "+
+				"so at most %d runs of this deployment can run at a time.",
+				plan.ConcurrencyLimit.ValueInt64(), pool.Name, *pool.ConcurrencyLimit, *pool.ConcurrencyLimit),
+		)
+	}
+}
+
+// deploymentConcurrencyMinVersion is the first Prefect version that supports
+// deployment concurrency_limit and concurrency_options.
+const deploymentConcurrencyMinVersion = "3.1.0"
+
+// errorOnUnsupportedConcurrency reports concurrency_limit and concurrency_options
+// set against a Prefect server that predates deployment concurrency, which
+// would otherwise accept and silently ignore them.
+func (r *DeploymentResource) errorOnUnsupportedConcurrency(ctx context.Context, config *DeploymentResourceModel, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || (config.ConcurrencyLimit.IsNull() && config.ConcurrencyOptions.IsNull()) {
+		return
+	}
+
+	client, err := r.client.Admin()
+	if err != nil {
+		return
+	}
+
+	// Not every server exposes its version, e.g. Prefect Cloud, which
+	// always supports deployment concurrency.
+	version, err := client.Version(ctx)
+	if err != nil {
+		return
+	}
+
+	supported, ok := helpers.IsVersionAtLeast(version, deploymentConcurrencyMinVersion)
+	if !ok || supported {
+		return
+	}
+
+	for _, attribute := range []struct {
+		name  string
+		value attr.Value
+	}{
+		{"concurrency_limit", config.ConcurrencyLimit},
+		{"concurrency_options", config.ConcurrencyOptions},
+	} {
+		if attribute.value.IsNull() {
+			continue
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attribute.name),
+			"Unsupported by the Prefect server version",
+			fmt.Sprintf("The `%s` attribute requires Prefect %s or later, but the server runs Prefect %s, which would ignore it. "+
+				"Potential resolutions: upgrade the Prefect server, or remove the attribute.",
+				attribute.name, deploymentConcurrencyMinVersion, version),
+		)
+	}
+}
+
+// parameterSchemaDefaults are the top-level values of a parameter schema
+// that the API may fill in when they aren't set.
+var parameterSchemaDefaults = map[string]interface{}{
+	"title":       "Parameters",
+	"type":        "object",
+	"properties":  map[string]interface{}{},
+	"required":    []interface{}{},
+	"definitions": map[string]interface{}{},
+}
+
+// parameterSchemaMatches returns whether a known schema in the model is the
+// same as the one returned by the API, ignoring top-level keys that are only
+// set to their defaults, so that a schema normalized by the API doesn't show
+// up as a diff.
+func parameterSchemaMatches(current jsontypes.Normalized, parameterSchema map[string]interface{}) bool {
+	if current.IsNull() || current.IsUnknown() {
+		return false
+	}
+
+	var currentSchema map[string]interface{}
+	if err := json.Unmarshal([]byte(current.ValueString()), &currentSchema); err != nil {
+		return false
+	}
+
+	// Round-trip the API's schema, so both sides use the types decoded from JSON.
+	byteSlice, err := json.Marshal(parameterSchema)
+	if err != nil {
+		return false
+	}
+	var apiSchema map[string]interface{}
+	if err := json.Unmarshal(byteSlice, &apiSchema); err != nil {
+		return false
+	}
+
+	withoutDefaults := func(schema map[string]interface{}) map[string]interface{} {
+		result := make(map[string]interface{}, len(schema))
+		for key, value := range schema {
+			if value == nil || reflect.DeepEqual(value, parameterSchemaDefaults[key]) {
+				continue
+			}
+			result[key] = value
+		}
+
+		return result
+	}
+
+	return reflect.DeepEqual(withoutDefaults(currentSchema), withoutDefaults(apiSchema))
+}
+
+// validateParametersAgainstSchema checks the configured parameters against
+// the parameter schema, so that parameters the API would reject show up as
+// errors in the plan, with the path of each offending parameter.
+func validateParametersAgainstSchema(plan, config *DeploymentResourceModel, resp *resource.ModifyPlanResponse) {
+	if config.Parameters.IsNull() || config.Parameters.IsUnknown() || plan.ParameterSchema.IsNull() || plan.ParameterSchema.IsUnknown() {
+		return
+	}
+
+	var parameterSchema, parameters map[string]interface{}
+	resp.Diagnostics.Append(plan.ParameterSchema.Unmarshal(&parameterSchema)...)
+	resp.Diagnostics.Append(config.Parameters.Unmarshal(&parameters)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attribute := path.Root("parameters")
+	if !config.ParametersObject.IsNull() {
+		attribute = path.Root("parameters_object")
+	}
+
+	for _, violation := range helpers.ValidateParameters(parameterSchema, parameters) {
+		resp.Diagnostics.AddAttributeError(
+			attribute,
+			"Invalid deployment parameter",
+			fmt.Sprintf("Parameter `%s` doesn't match `parameter_openapi_schema`: %s. "+
+				"The schema is enforced because `enforce_parameter_schema` is set.", violation.Path, violation.Message),
+		)
+	}
+}
+
+// warnSecretParametersInPlainText warns about parameters set in `parameters`
+// that the parameter schema marks as secret, since their values would show
+// up in plans and state.
+func warnSecretParametersInPlainText(plan, config *DeploymentResourceModel, resp *resource.ModifyPlanResponse) {
+	if config.Parameters.IsNull() || config.Parameters.IsUnknown() || plan.ParameterSchema.IsNull() || plan.ParameterSchema.IsUnknown() {
+		return
+	}
+
+	var parameterSchema, parameters map[string]interface{}
+	resp.Diagnostics.Append(plan.ParameterSchema.Unmarshal(&parameterSchema)...)
+	resp.Diagnostics.Append(config.Parameters.Unmarshal(&parameters)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attribute := path.Root("parameters")
+	if !config.ParametersObject.IsNull() {
+		attribute = path.Root("parameters_object")
+	}
+
+	for _, name := range helpers.SecretParameters(parameterSchema) {
+		if _, ok := parameters[name]; !ok {
+			continue
+		}
+
+		resp.Diagnostics.AddAttributeWarning(
+			attribute,
+			"Secret deployment parameter",
+			fmt.Sprintf("Parameter `%s` is marked as secret by `parameter_openapi_schema`, but is set in `%s`, "+
+				"so its value is shown in plans and stored in state in the clear. "+
+				"Set it in `sensitive_parameters` instead to redact it.", name, attribute),
+		)
+	}
+}
+
+// copyDeploymentParametersToModel maps a deployment's parameters to
+// `parameters` and `sensitive_parameters`. Parameters set in
+// `sensitive_parameters` are kept out of `parameters`, and so are the ones
+// the parameter schema marks as secret, unless they're set in `parameters`.
+func copyDeploymentParametersToModel(deployment *api.Deployment, model *DeploymentResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	current := map[string]interface{}{}
+	if !model.Parameters.IsNull() && !model.Parameters.IsUnknown() {
+		diags.Append(model.Parameters.Unmarshal(&current)...)
+	}
+	configured := map[string]interface{}{}
+	if !model.SensitiveParameters.IsNull() && !model.SensitiveParameters.IsUnknown() {
+		diags.Append(model.SensitiveParameters.Unmarshal(&configured)...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	secret := make(map[string]bool, len(configured))
+	for name := range configured {
+		secret[name] = true
+	}
+	for _, name := range helpers.SecretParameters(deployment.ParameterOpenAPISchema) {
+		if _, ok := current[name]; !ok {
+			secret[name] = true
+		}
+	}
+
+	var parameters map[string]interface{}
+	if deployment.Parameters != nil {
+		parameters = make(map[string]interface{}, len(deployment.Parameters))
+	}
+	sensitive := map[string]interface{}{}
+	for name, value := range deployment.Parameters {
+		if secret[name] {
+			sensitive[name] = value
+		} else {
+			parameters[name] = value
+		}
+	}
+
+	byteSlice, err := json.Marshal(parameters)
+	if err != nil {
+		diags.Append(helpers.SerializeDataErrorDiagnostic("parameters", "Deployment parameters", err))
+
+		return diags
+	}
+	model.ParametersAll = jsontypes.NewNormalizedValue(string(byteSlice))
+
+	// In merge mode, parameters set outside of Terraform are kept out of
+	// parameters, so they don't show up as drift against the configuration.
+	if model.MergeParameters.ValueBool() && !model.Parameters.IsNull() && !model.Parameters.IsUnknown() {
+		managed := make(map[string]interface{}, len(current))
+		for name := range current {
+			if value, ok := parameters[name]; ok {
+				managed[name] = value
+			}
+		}
+		parameters = managed
+
+		byteSlice, err = json.Marshal(parameters)
+		if err != nil {
+			diags.Append(helpers.SerializeDataErrorDiagnostic("parameters", "Deployment parameters", err))
+
+			return diags
+		}
+	}
+	model.Parameters = jsontypes.NewNormalizedValue(string(byteSlice))
+
+	if len(sensitive) == 0 && (model.SensitiveParameters.IsNull() || model.SensitiveParameters.IsUnknown()) {
+		model.SensitiveParameters = jsontypes.NewNormalizedNull()
+
+		return diags
+	}
+
+	byteSlice, err = json.Marshal(sensitive)
+	if err != nil {
+		diags.Append(helpers.SerializeDataErrorDiagnostic("sensitive_parameters", "Deployment sensitive parameters", err))
+
+		return diags
+	}
+	model.SensitiveParameters = jsontypes.NewNormalizedValue(string(byteSlice))
+
+	return diags
+}
+
+// inferParameterSchema infers a deployment's parameter schema from its
+// parameters, for infer_parameter_schema. Unset parameters infer a schema
+// without any.
+func inferParameterSchema(parameters jsontypes.Normalized) (jsontypes.Normalized, diag.Diagnostics) {
+	var data map[string]interface{}
+	if !parameters.IsNull() && !parameters.IsUnknown() {
+		diags := parameters.Unmarshal(&data)
+		if diags.HasError() {
+			return jsontypes.NewNormalizedNull(), diags
+		}
+	}
+
+	byteSlice, err := json.Marshal(helpers.InferParameterSchema(data))
+	if err != nil {
+		return jsontypes.NewNormalizedNull(), diag.Diagnostics{
+			helpers.SerializeDataErrorDiagnostic("parameter_openapi_schema", "Deployment parameter schema", err),
+		}
+	}
+
+	return jsontypes.NewNormalizedValue(string(byteSlice)), nil
+}
+
+// deploymentContext describes the deployment a diagnostic is about, by name
+// and flow ID rather than only by ID, so that the failing resource can be
+// told apart in a large apply. Values that aren't known are left out, e.g.
+// the name of a deployment being imported, and the ID is used instead.
+func deploymentContext(model *DeploymentResourceModel) string {
+	var subject string
+	switch {
+	case !model.Name.IsNull() && !model.Name.IsUnknown() && model.Name.ValueString() != "":
+		subject = fmt.Sprintf("Deployment %q", model.Name.ValueString())
+	case !model.ID.IsNull() && !model.ID.IsUnknown() && model.ID.ValueString() != "":
+		subject = "Deployment " + model.ID.ValueString()
+	default:
+		subject = "Deployment"
+	}
+
+	if !model.FlowID.IsNull() && !model.FlowID.IsUnknown() {
+		subject += fmt.Sprintf(" (flow_id %s)", model.FlowID.ValueString())
+	}
+
+	return subject + ": "
+}
+
+// defaultEnforceParameterSchema returns whether a deployment that doesn't set
+// enforce_parameter_schema enforces its parameter schema: only when it provides
+// one and the provider is configured to enforce provided schemas.
+func defaultEnforceParameterSchema(settings helpers.ProviderSettings, config *DeploymentResourceModel) bool {
+	return settings.EnforceParameterSchemaWhenProvided && !config.ParameterSchema.IsNull()
+}
+
+// warnOnUnschedulableDeployment adds a warning when neither a work pool nor
+// a work queue is set, since no worker will ever pick up the deployment's runs.
+//
+// Values that aren't configured are computed by the API, so they only count
+// as set once they're known in the plan.
+func warnOnUnschedulableDeployment(plan, config *DeploymentResourceModel, resp *resource.ModifyPlanResponse) {
+	isUnset := func(configured, planned types.String) bool {
+		if configured.IsUnknown() || configured.ValueString() != "" {
+			return false
+		}
+
+		return planned.IsUnknown() || planned.ValueString() == ""
+	}
+
+	if isUnset(config.WorkPoolName, plan.WorkPoolName) && isUnset(config.WorkQueueName, plan.WorkQueueName) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("work_pool_name"),
+			"Deployment has no work pool or work queue",
+			"Neither work_pool_name nor work_queue_name is set, so runs of this deployment won't be picked up by any worker. "+
+				"Set work_pool_name (and optionally work_queue_name) to schedule its runs.",
+		)
+	}
+}
+
+// jobVariablesToNormalized serializes the deployment's job variables for state.
+// The API may return null instead of an empty object when no job variables
+// are set, so we normalize both to "{}" to keep the state stable.
+func jobVariablesToNormalized(jobVariables map[string]interface{}) (jsontypes.Normalized, error) {
+	if jobVariables == nil {
+		jobVariables = map[string]interface{}{}
+	}
+
+	byteSlice, err := json.Marshal(jobVariables)
+	if err != nil {
+		return jsontypes.NewNormalizedNull(), fmt.Errorf("failed to serialize job variables: %w", err)
+	}
+
+	return jsontypes.NewNormalizedValue(string(byteSlice)), nil
+}
+
+// pullStepsToNormalized serializes the deployment's pull steps for state.
+// As with job variables, the API may return null instead of an empty list,
+// so we normalize both to "[]" to keep the state stable.
+func pullStepsToNormalized(pullSteps []api.PullStep) (jsontypes.Normalized, error) {
+	if pullSteps == nil {
+		pullSteps = []api.PullStep{}
+	}
+
+	byteSlice, err := json.Marshal(pullSteps)
+	if err != nil {
+		return jsontypes.NewNormalizedNull(), fmt.Errorf("failed to serialize pull steps: %w", err)
+	}
+
+	return jsontypes.NewNormalizedValue(string(byteSlice)), nil
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DeploymentResourceModel
+
+	// Populate the model from resource configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.Deployments(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating deployment client",
+			fmt.Sprintf("%sCould not create deployment client, unexpected error: %s. This is a bug in the provider, please report this to the maintainers.", deploymentContext(&plan), err.Error()),
+		)
+
+		return
+	}
+
+	var tags []string
+	resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &tags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The model is populated from the configuration, so neither the
+	// flow's tags nor the provider's default tags have been merged in yet.
+	if plan.InheritFlowTags.ValueBool() {
+		tags, err = mergeFlowTags(ctx, r.client, &plan, tags)
+		if err != nil {
+			resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Flow", "get", err))
+
+			return
+		}
+	}
+	tags = helpers.MergeDefaultTags(tags, r.settings.DefaultTags)
+
+	// parameters may have been serialized from parameters_object while
+	// planning, and sensitive_parameters kept from a prior state, so we'll
+	// take them from the plan when they're known there.
+	var parameters, sensitiveParameters jsontypes.Normalized
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("parameters"), &parameters)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("sensitive_parameters"), &sensitiveParameters)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !parameters.IsUnknown() {
+		plan.Parameters = parameters
+	}
+	if !sensitiveParameters.IsUnknown() {
+		plan.SensitiveParameters = sensitiveParameters
+	}
+
+	var data map[string]interface{}
+	if !plan.Parameters.IsNull() {
+		resp.Diagnostics.Append(plan.Parameters.Unmarshal(&data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if !plan.SensitiveParameters.IsNull() {
+		sensitiveParameters := map[string]interface{}{}
+		resp.Diagnostics.Append(plan.SensitiveParameters.Unmarshal(&sensitiveParameters)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if data == nil {
+			data = make(map[string]interface{}, len(sensitiveParameters))
+		}
+		for name, value := range sensitiveParameters {
+			data[name] = value
+		}
+	}
+
+	var jobVariables map[string]interface{}
+	if !plan.JobVariables.IsNull() {
+		resp.Diagnostics.Append(plan.JobVariables.Unmarshal(&jobVariables)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var pullSteps []api.PullStep
+	if !plan.PullSteps.IsNull() {
+		resp.Diagnostics.Append(plan.PullSteps.Unmarshal(&pullSteps)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// The model is populated from the configuration, so an inferred
+	// parameter schema is inferred again, from the planned parameters
+	// as they may only be known now, and enforced when planned to be.
+	if plan.InferParameterSchema.ValueBool() && plan.ParameterSchema.IsNull() {
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("enforce_parameter_schema"), &plan.EnforceParameterSchema)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var diags diag.Diagnostics
+		plan.ParameterSchema, diags = inferParameterSchema(plan.Parameters)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var parameterSchema map[string]interface{}
+	if !plan.ParameterSchema.IsNull() {
+		resp.Diagnostics.Append(plan.ParameterSchema.Unmarshal(&parameterSchema)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	concurrencyOptions, diags := concurrencyOptionsFromModel(ctx, plan.ConcurrencyOptions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// version_info may have been filled in from the environment
+	// while planning, so we'll take it from the plan.
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("version_info"), &plan.VersionInfo)...)
+	versionInfo, diags := versionInfoFromModel(ctx, plan.VersionInfo)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The model is populated from the configuration, so an unset
+	// paused falls back to the provider's default for the workspace.
+	if plan.Paused.IsNull() {
+		plan.Paused = types.BoolValue(r.settings.DefaultPaused(plan.WorkspaceID.ValueUUID()))
+	}
+	if plan.EnforceParameterSchema.IsNull() {
+		plan.EnforceParameterSchema = types.BoolValue(defaultEnforceParameterSchema(r.settings, &plan))
+	}
+
+	deployment, err := client.Create(ctx, api.DeploymentCreate{
+		ConcurrencyLimit:       plan.ConcurrencyLimit.ValueInt64Pointer(),
+		ConcurrencyOptions:     concurrencyOptions,
+		Description:            plan.Description.ValueString(),
+		EnforceParameterSchema: plan.EnforceParameterSchema.ValueBool(),
+		Entrypoint:             plan.Entrypoint.ValueString(),
+		FlowID:                 plan.FlowID.ValueUUID(),
+		JobVariables:           jobVariables,
+		ManifestPath:           plan.ManifestPath.ValueString(),
+		Name:                   plan.Name.ValueString(),
+		ParameterOpenAPISchema: parameterSchema,
+		Parameters:             data,
+		Path:                   plan.Path.ValueString(),
+		Paused:                 plan.Paused.ValueBool(),
+		PullSteps:              pullSteps,
+		Tags:                   tags,
+		Version:                plan.Version.ValueString(),
+		VersionInfo:            versionInfo,
+		WorkPoolName:           plan.WorkPoolName.ValueString(),
+		WorkQueueName:          plan.WorkQueueName.ValueString(),
+
+		StorageDocumentID:        plan.StorageDocumentID.ValueUUIDPointer(),
+		InfrastructureDocumentID: plan.InfrastructureDocumentID.ValueUUIDPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating deployment",
+			fmt.Sprintf("%sCould not create deployment, unexpected error: %s", deploymentContext(&plan), err),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(copyDeploymentToModel(ctx, deployment, &plan, r.settings.DefaultTags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use the server's view of job_variables and pull_steps, so that
+	// an omitted value lands in state the same way Read will see it.
+	plan.JobVariables, err = jobVariablesToNormalized(deployment.JobVariables)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.SerializeDataErrorDiagnostic("job_variables", "Deployment job variables", err))
+
+		return
+	}
+
+	plan.PullSteps, err = pullStepsToNormalized(deployment.PullSteps)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.SerializeDataErrorDiagnostic("pull_steps", "Deployment pull steps", err))
+
+		return
+	}
+
+	// replace_on_version_change, inherit_flow_tags, merge_parameters, infer_parameter_schema, version_info_from_env
+	// and skip_destroy are not stored in the API, so the model is populated from the configuration and may still be null here.
+	if plan.ReplaceOnVersionChange.IsNull() {
+		plan.ReplaceOnVersionChange = types.BoolValue(false)
+	}
+	if plan.SkipDestroy.IsNull() {
+		plan.SkipDestroy = types.BoolValue(false)
+	}
+	if plan.InheritFlowTags.IsNull() {
+		plan.InheritFlowTags = types.BoolValue(false)
+	}
+	if plan.MergeParameters.IsNull() {
+		plan.MergeParameters = types.BoolValue(false)
+	}
+	if plan.InferParameterSchema.IsNull() {
+		plan.InferParameterSchema = types.BoolValue(false)
+	}
+	if plan.VersionInfoFromEnv.IsNull() {
+		plan.VersionInfoFromEnv = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *DeploymentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model DeploymentResourceModel
+
+	// Populate the model from state and emit diagnostics on error
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.Deployments(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating deployment client",
-			fmt.Sprintf("Could not create deployment client, unexpected error: %s. This is a bug in the provider, please report this to the maintainers.", err.Error()),
+			fmt.Sprintf("%sCould not create deployment client, unexpected error: %s. This is a bug in the provider, please report this to the maintainers.", deploymentContext(&model), err.Error()),
 		)
+
+		return
+	}
+
+	// A deployment can be imported + read by either ID or Handle
+	// If both are set, we prefer the ID
+	var deployment *api.Deployment
+	if !model.ID.IsNull() {
+		var deploymentID uuid.UUID
+		deploymentID, err = uuid.Parse(model.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Error parsing Deployment ID",
+				fmt.Sprintf("%sCould not parse deployment ID to UUID, unexpected error: %s", deploymentContext(&model), err.Error()),
+			)
+
+			return
+		}
+
+		deployment, err = client.Get(ctx, deploymentID)
 	}
 
-	deploymentID, err := uuid.Parse(model.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("id"),
-			"Error parsing Deployment ID",
-			fmt.Sprintf("Could not parse deployment ID to UUID, unexpected error: %s", err.Error()),
+		resp.Diagnostics.AddError(
+			"Error refreshing deployment state",
+			fmt.Sprintf("%sCould not read Deployment, unexpected error: %s", deploymentContext(&model), err.Error()),
 		)
 
 		return
 	}
 
-	var tags []string
-	resp.Diagnostics.Append(model.Tags.ElementsAs(ctx, &tags, false)...)
+	// Always take the server's values here, including for Optional+Computed
+	// attributes like manifest_path and path: UseStateForUnknown only applies
+	// when planning, so refreshing them is what surfaces out-of-band changes
+	// (e.g. a redeploy from the CLI) as drift against the configuration.
+	resp.Diagnostics.Append(copyDeploymentToModel(ctx, deployment, &model, r.settings.DefaultTags)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var parameters map[string]interface{}
-	resp.Diagnostics.Append(model.Parameters.Unmarshal(&parameters)...)
+	resp.Diagnostics.Append(copyDeploymentParametersToModel(deployment, &model)...)
+
+	model.JobVariables, err = jobVariablesToNormalized(deployment.JobVariables)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.SerializeDataErrorDiagnostic("job_variables", "Deployment job variables", err))
+	}
+
+	model.PullSteps, err = pullStepsToNormalized(deployment.PullSteps)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.SerializeDataErrorDiagnostic("pull_steps", "Deployment pull steps", err))
+	}
+
+	// replace_on_version_change, inherit_flow_tags, merge_parameters, infer_parameter_schema, version_info_from_env
+	// and skip_destroy are not stored in the API, so we'll fall back to the defaults when importing.
+	if model.ReplaceOnVersionChange.IsNull() {
+		model.ReplaceOnVersionChange = types.BoolValue(false)
+	}
+	if model.SkipDestroy.IsNull() {
+		model.SkipDestroy = types.BoolValue(false)
+	}
+	if model.InheritFlowTags.IsNull() {
+		model.InheritFlowTags = types.BoolValue(false)
+	}
+	if model.MergeParameters.IsNull() {
+		model.MergeParameters = types.BoolValue(false)
+	}
+	if model.InferParameterSchema.IsNull() {
+		model.InferParameterSchema = types.BoolValue(false)
+	}
+	if model.VersionInfoFromEnv.IsNull() {
+		model.VersionInfoFromEnv = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// changedString returns the planned value if it differs from the prior state,
+// or nil if it is unchanged or not yet known.
+func changedString(plan, state types.String) *string {
+	if plan.IsUnknown() || plan.IsNull() || plan.Equal(state) {
+		return nil
+	}
+
+	return plan.ValueStringPointer()
+}
+
+// changedBool returns the planned value if it differs from the prior state,
+// or nil if it is unchanged or not yet known.
+func changedBool(plan, state types.Bool) *bool {
+	if plan.IsUnknown() || plan.IsNull() || plan.Equal(state) {
+		return nil
+	}
+
+	return plan.ValueBoolPointer()
+}
+
+// changedInt64 returns the planned value if it differs from the prior state,
+// pointing to nil if it was removed, or nil if it is unchanged or not yet known.
+func changedInt64(plan, state types.Int64) **int64 {
+	if plan.IsUnknown() || plan.Equal(state) {
+		return nil
+	}
+
+	value := plan.ValueInt64Pointer()
+
+	return &value
+}
+
+// changedUUID returns the planned value if it differs from the prior state,
+// pointing to nil if it was removed, or nil if it is unchanged or not yet known.
+func changedUUID(plan, state customtypes.UUIDValue) **uuid.UUID {
+	if plan.IsUnknown() || plan.Equal(state) {
+		return nil
+	}
+
+	value := plan.ValueUUIDPointer()
+
+	return &value
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+//
+// Only the attributes that changed between the prior state and the plan are sent,
+// so that values managed by the server (e.g. manifest_path) aren't clobbered.
+func (r *DeploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model, state DeploymentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	client, err := r.client.Deployments(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating deployment client",
+			fmt.Sprintf("%sCould not create deployment client, unexpected error: %s. This is a bug in the provider, please report this to the maintainers.", deploymentContext(&model), err.Error()),
+		)
+
+		return
+	}
+
+	deploymentID, err := uuid.Parse(model.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Error parsing Deployment ID",
+			fmt.Sprintf("%sCould not parse deployment ID to UUID, unexpected error: %s", deploymentContext(&model), err.Error()),
+		)
+
+		return
+	}
+
 	payload := api.DeploymentUpdate{
-		Description:            model.Description.ValueString(),
-		EnforceParameterSchema: model.EnforceParameterSchema.ValueBool(),
-		Entrypoint:             model.Entrypoint.ValueString(),
-		ManifestPath:           model.ManifestPath.ValueString(),
-		Parameters:             parameters,
-		Path:                   model.Path.ValueString(),
-		Paused:                 model.Paused.ValueBool(),
-		Tags:                   tags,
-		Version:                model.Version.ValueString(),
-		WorkPoolName:           model.WorkPoolName.ValueString(),
-		WorkQueueName:          model.WorkQueueName.ValueString(),
+		ConcurrencyLimit:       changedInt64(model.ConcurrencyLimit, state.ConcurrencyLimit),
+		Description:            changedString(model.Description, state.Description),
+		EnforceParameterSchema: changedBool(model.EnforceParameterSchema, state.EnforceParameterSchema),
+		Entrypoint:             changedString(model.Entrypoint, state.Entrypoint),
+		ManifestPath:           changedString(model.ManifestPath, state.ManifestPath),
+		Path:                   changedString(model.Path, state.Path),
+		Version:                changedString(model.Version, state.Version),
+		WorkPoolName:           changedString(model.WorkPoolName, state.WorkPoolName),
+		WorkQueueName:          changedString(model.WorkQueueName, state.WorkQueueName),
+
+		StorageDocumentID:        changedUUID(model.StorageDocumentID, state.StorageDocumentID),
+		InfrastructureDocumentID: changedUUID(model.InfrastructureDocumentID, state.InfrastructureDocumentID),
+	}
+
+	var diags diag.Diagnostics
+
+	if !model.ConcurrencyOptions.IsUnknown() && !model.ConcurrencyOptions.Equal(state.ConcurrencyOptions) {
+		var concurrencyOptions *api.ConcurrencyOptions
+		concurrencyOptions, diags = concurrencyOptionsFromModel(ctx, model.ConcurrencyOptions)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		payload.ConcurrencyOptions = &concurrencyOptions
+	}
+
+	if !model.VersionInfo.Equal(state.VersionInfo) {
+		payload.VersionInfo, diags = versionInfoFromModel(ctx, model.VersionInfo)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !model.Tags.IsUnknown() && !model.TagsAll.Equal(state.TagsAll) {
+		tags := []string{}
+		resp.Diagnostics.Append(model.Tags.ElementsAs(ctx, &tags, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if model.InheritFlowTags.ValueBool() {
+			tags, err = mergeFlowTags(ctx, r.client, &model, tags)
+			if err != nil {
+				resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Flow", "get", err))
+
+				return
+			}
+		}
+		tags = helpers.MergeDefaultTags(tags, r.settings.DefaultTags)
+		payload.Tags = &tags
+	}
+
+	if !model.JobVariables.IsUnknown() && !model.JobVariables.IsNull() && !model.JobVariables.Equal(state.JobVariables) {
+		jobVariables := map[string]interface{}{}
+		resp.Diagnostics.Append(model.JobVariables.Unmarshal(&jobVariables)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		payload.JobVariables = &jobVariables
+	}
+
+	if !model.PullSteps.IsUnknown() && !model.PullSteps.IsNull() && !model.PullSteps.Equal(state.PullSteps) {
+		pullSteps := []api.PullStep{}
+		resp.Diagnostics.Append(model.PullSteps.Unmarshal(&pullSteps)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		payload.PullSteps = &pullSteps
+	}
+
+	// The parameter schema can't be inferred while planning when the
+	// parameters aren't known yet, so it's inferred now.
+	if model.InferParameterSchema.ValueBool() && model.ParameterSchema.IsUnknown() {
+		var diags diag.Diagnostics
+		model.ParameterSchema, diags = inferParameterSchema(model.Parameters)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !model.ParameterSchema.IsUnknown() && !model.ParameterSchema.IsNull() && !model.ParameterSchema.Equal(state.ParameterSchema) {
+		parameterSchema := map[string]interface{}{}
+		resp.Diagnostics.Append(model.ParameterSchema.Unmarshal(&parameterSchema)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		payload.ParameterOpenAPISchema = &parameterSchema
 	}
+
+	parametersChanged := !model.Parameters.IsUnknown() && !model.Parameters.IsNull() && !model.Parameters.Equal(state.Parameters)
+	sensitiveParametersChanged := !model.SensitiveParameters.IsUnknown() && !model.SensitiveParameters.Equal(state.SensitiveParameters)
+	if parametersChanged || sensitiveParametersChanged {
+		// Parameters are replaced as a whole, so the sensitive ones are sent
+		// along with the others, whichever of the two changed.
+		parameters := map[string]interface{}{}
+		if !model.Parameters.IsUnknown() && !model.Parameters.IsNull() {
+			resp.Diagnostics.Append(model.Parameters.Unmarshal(&parameters)...)
+		} else if !state.Parameters.IsNull() {
+			resp.Diagnostics.Append(state.Parameters.Unmarshal(&parameters)...)
+		}
+		if !model.SensitiveParameters.IsUnknown() && !model.SensitiveParameters.IsNull() {
+			sensitiveParameters := map[string]interface{}{}
+			resp.Diagnostics.Append(model.SensitiveParameters.Unmarshal(&sensitiveParameters)...)
+			for name, value := range sensitiveParameters {
+				parameters[name] = value
+			}
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// In merge mode, parameters set outside of Terraform are kept, so the
+		// configured parameters are merged into the deployment's current ones.
+		if model.MergeParameters.ValueBool() {
+			current, err := client.Get(ctx, deploymentID)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error refreshing Deployment state",
+					fmt.Sprintf("%sCould not read Deployment, unexpected error: %s", deploymentContext(&model), err.Error()),
+				)
+
+				return
+			}
+			parameters = helpers.MergeObjects(current.Parameters, parameters)
+		}
+		payload.Parameters = &parameters
+	}
+
 	err = client.Update(ctx, deploymentID, payload)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating deployment",
-			fmt.Sprintf("Could not update deployment, unexpected error: %s", err),
+			fmt.Sprintf("%sCould not update deployment, unexpected error: %s", deploymentContext(&model), err),
 		)
 
 		return
 	}
 
+	// Pausing and resuming go through their dedicated endpoints rather than
+	// the update above, so that many deployments can be paused cheaply,
+	// e.g. during maintenance.
+	if !model.Paused.IsUnknown() && !model.Paused.Equal(state.Paused) {
+		err = client.SetPaused(ctx, deploymentID, model.Paused.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("paused"),
+				"Error updating deployment",
+				fmt.Sprintf("%sCould not pause or resume deployment, unexpected error: %s", deploymentContext(&model), err),
+			)
+
+			return
+		}
+	}
+
 	deployment, err := client.Get(ctx, deploymentID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error refreshing Deployment state",
-			fmt.Sprintf("Could not read Deployment, unexpected error: %s", err.Error()),
+			fmt.Sprintf("%sCould not read Deployment, unexpected error: %s", deploymentContext(&model), err.Error()),
 		)
 
 		return
 	}
 
-	resp.Diagnostics.Append(copyDeploymentToModel(ctx, deployment, &model)...)
+	resp.Diagnostics.Append(copyDeploymentToModel(ctx, deployment, &model, r.settings.DefaultTags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(copyDeploymentParametersToModel(deployment, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	byteSlice, err := json.Marshal(deployment.Parameters)
+	model.JobVariables, err = jobVariablesToNormalized(deployment.JobVariables)
 	if err != nil {
-		resp.Diagnostics.Append(helpers.SerializeDataErrorDiagnostic("parameters", "Deployment parameters", err))
+		resp.Diagnostics.Append(helpers.SerializeDataErrorDiagnostic("job_variables", "Deployment job variables", err))
+
+		return
+	}
+
+	model.PullSteps, err = pullStepsToNormalized(deployment.PullSteps)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.SerializeDataErrorDiagnostic("pull_steps", "Deployment pull steps", err))
 
 		return
 	}
-	model.Parameters = jsontypes.NewNormalizedValue(string(byteSlice))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
@@ -485,12 +1979,19 @@ func (r *DeploymentResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	// With skip_destroy, the deployment is only removed from the state.
+	if state.SkipDestroy.ValueBool() {
+		return
+	}
+
 	client, err := r.client.Deployments(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating deployment client",
-			fmt.Sprintf("Could not create deployment client, unexpected error: %s. This is a bug in the provider, please report this to the maintainers.", err.Error()),
+			fmt.Sprintf("%sCould not create deployment client, unexpected error: %s. This is a bug in the provider, please report this to the maintainers.", deploymentContext(&state), err.Error()),
 		)
+
+		return
 	}
 
 	deploymentID, err := uuid.Parse(state.ID.ValueString())
@@ -498,7 +1999,7 @@ func (r *DeploymentResource) Delete(ctx context.Context, req resource.DeleteRequ
 		resp.Diagnostics.AddAttributeError(
 			path.Root("id"),
 			"Error parsing Deployment ID",
-			fmt.Sprintf("Could not parse deployment ID to UUID, unexpected error: %s", err.Error()),
+			fmt.Sprintf("%sCould not parse deployment ID to UUID, unexpected error: %s", deploymentContext(&state), err.Error()),
 		)
 
 		return
@@ -508,7 +2009,7 @@ func (r *DeploymentResource) Delete(ctx context.Context, req resource.DeleteRequ
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting Deployment",
-			fmt.Sprintf("Could not delete Deployment, unexpected error: %s", err),
+			fmt.Sprintf("%sCould not delete Deployment, unexpected error: %s", deploymentContext(&state), err),
 		)
 
 		return
@@ -0,0 +1,513 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var (
+	_ = resource.ResourceWithConfigure(&DeploymentSLAResource{})
+	_ = resource.ResourceWithValidateConfig(&DeploymentSLAResource{})
+)
+
+// DeploymentSLAResource contains state for the resource.
+type DeploymentSLAResource struct {
+	client api.PrefectClient
+}
+
+// DeploymentSLAResourceModel defines the Terraform resource model.
+type DeploymentSLAResourceModel struct {
+	ID          types.String          `tfsdk:"id"`
+	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+
+	DeploymentID customtypes.UUIDValue `tfsdk:"deployment_id"`
+	SLAs         types.List            `tfsdk:"slas"`
+	SLAIDs       types.Map             `tfsdk:"sla_ids"`
+}
+
+// DeploymentSLAModel defines a single SLA in the `slas` list.
+type DeploymentSLAModel struct {
+	Name     types.String `tfsdk:"name"`
+	Duration types.Int64  `tfsdk:"duration"`
+	Severity types.String `tfsdk:"severity"`
+}
+
+// managedSLA is an SLA managed by the resource. SLAs are matched
+// by name between the configuration and the state.
+type managedSLA struct {
+	ID       string
+	Name     string
+	Duration int64
+	Severity string
+}
+
+// deploymentSLASeverities are the severities accepted by the API.
+var deploymentSLASeverities = []string{"minor", "low", "moderate", "high", "critical"}
+
+// deploymentSLAAttributeTypes are the attribute types of an element of `slas`.
+var deploymentSLAAttributeTypes = map[string]attr.Type{
+	"name":     types.StringType,
+	"duration": types.Int64Type,
+	"severity": types.StringType,
+}
+
+// NewDeploymentSLAResource returns a new DeploymentSLAResource.
+//
+//nolint:ireturn // required by Terraform API
+func NewDeploymentSLAResource() resource.Resource {
+	return &DeploymentSLAResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *DeploymentSLAResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_sla"
+}
+
+// Configure initializes runtime state for the resource.
+func (r *DeploymentSLAResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*helpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("resource", req.ProviderData))
+
+		return
+	}
+
+	r.client = data.Client
+}
+
+// Schema defines the schema for the resource.
+func (r *DeploymentSLAResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The resource `deployment_sla` manages the SLAs of a Prefect Cloud Deployment. " +
+			"An SLA fires an alert when a flow run of the deployment runs for longer than its duration. " +
+			"Adding an SLA to `slas` creates it, changing its duration or severity updates it, and removing it deletes the SLA.",
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier for this set of SLAs, which is the Deployment ID (UUID)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"deployment_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Deployment ID (UUID) the SLAs apply to",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"slas": schema.ListNestedAttribute{
+				Description: "SLAs of the deployment. Names must be unique.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the SLA",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"duration": schema.Int64Attribute{
+							Description: "Number of seconds a flow run can run for before the SLA fires",
+							Required:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"severity": schema.StringAttribute{
+							Description: fmt.Sprintf("Severity of the alert fired by the SLA. One of %v.", deploymentSLASeverities),
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString("high"),
+							Validators: []validator.String{
+								stringvalidator.OneOf(deploymentSLASeverities...),
+							},
+						},
+					},
+				},
+			},
+			"sla_ids": schema.MapAttribute{
+				Description: "Map of SLA names to their IDs (UUID)",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig ensures that SLA names are unique, as SLAs are matched by name.
+func (r *DeploymentSLAResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config DeploymentSLAResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.SLAs.IsUnknown() {
+		return
+	}
+
+	var slas []DeploymentSLAModel
+	resp.Diagnostics.Append(config.SLAs.ElementsAs(ctx, &slas, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := map[string]bool{}
+	for i, sla := range slas {
+		if sla.Name.IsNull() || sla.Name.IsUnknown() {
+			continue
+		}
+
+		name := sla.Name.ValueString()
+		if seen[name] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("slas").AtListIndex(i).AtName("name"),
+				"Duplicate SLA name",
+				fmt.Sprintf("The SLA name %q is used more than once. SLA names must be unique.", name),
+			)
+		}
+		seen[name] = true
+	}
+}
+
+// managedSLAsFromModel builds the list of SLAs tracked in a model, in the
+// order of `slas`, using the stored IDs. SLAs without an ID aren't tracked.
+func managedSLAsFromModel(ctx context.Context, model *DeploymentSLAResourceModel) ([]managedSLA, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var slas []DeploymentSLAModel
+	ids := map[string]string{}
+	diags.Append(model.SLAs.ElementsAs(ctx, &slas, false)...)
+	if !model.SLAIDs.IsNull() && !model.SLAIDs.IsUnknown() {
+		diags.Append(model.SLAIDs.ElementsAs(ctx, &ids, false)...)
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	managed := make([]managedSLA, 0, len(slas))
+	for _, sla := range slas {
+		managed = append(managed, managedSLA{
+			ID:       ids[sla.Name.ValueString()],
+			Name:     sla.Name.ValueString(),
+			Duration: sla.Duration.ValueInt64(),
+			Severity: sla.Severity.ValueString(),
+		})
+	}
+
+	return managed, diags
+}
+
+// copyManagedSLAsToModel maps the reconciled SLAs to the model.
+func copyManagedSLAsToModel(ctx context.Context, slas []managedSLA, model *DeploymentSLAResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	elements := make([]DeploymentSLAModel, 0, len(slas))
+	ids := make(map[string]string, len(slas))
+	for _, sla := range slas {
+		elements = append(elements, DeploymentSLAModel{
+			Name:     types.StringValue(sla.Name),
+			Duration: types.Int64Value(sla.Duration),
+			Severity: types.StringValue(sla.Severity),
+		})
+		ids[sla.Name] = sla.ID
+	}
+
+	slasValue, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: deploymentSLAAttributeTypes}, elements)
+	diags.Append(d...)
+	slaIDsValue, d := types.MapValueFrom(ctx, types.StringType, ids)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	model.SLAs = slasValue
+	model.SLAIDs = slaIDsValue
+
+	return diags
+}
+
+// reconcileDeploymentSLAs creates, updates and deletes SLAs so that the
+// current list matches the desired one.
+//
+// Like reconcileVariables, every SLA is attempted even if an earlier one
+// fails, and the returned list only reflects the operations that succeeded,
+// so the state saved after a partial failure is accurate.
+func reconcileDeploymentSLAs(ctx context.Context, client api.DeploymentSLAsClient, deploymentID uuid.UUID, current, desired []managedSLA) ([]managedSLA, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	currentByName := make(map[string]managedSLA, len(current))
+	for _, sla := range current {
+		currentByName[sla.Name] = sla
+	}
+	desiredNames := make(map[string]bool, len(desired))
+	for _, sla := range desired {
+		desiredNames[sla.Name] = true
+	}
+
+	result := make([]managedSLA, 0, len(desired))
+
+	// SLAs are deleted first, so that a removed SLA's name can be reused.
+	for _, sla := range current {
+		if desiredNames[sla.Name] {
+			continue
+		}
+
+		slaID, err := uuid.Parse(sla.ID)
+		if err != nil {
+			diags.Append(helpers.ParseUUIDErrorDiagnostic("Deployment SLA", err))
+			result = append(result, sla)
+
+			continue
+		}
+
+		if err := client.Delete(ctx, deploymentID, slaID); err != nil {
+			diags.Append(deploymentSLAReconcileErrorDiagnostic(sla.Name, "delete", err))
+			result = append(result, sla)
+		}
+	}
+
+	for _, sla := range desired {
+		payload := api.DeploymentSLAUpsert{
+			Name:     sla.Name,
+			Duration: sla.Duration,
+			Severity: sla.Severity,
+		}
+
+		existing, exists := currentByName[sla.Name]
+		switch {
+		case exists && existing.Duration == sla.Duration && existing.Severity == sla.Severity:
+			result = append(result, existing)
+
+		case exists:
+			slaID, err := uuid.Parse(existing.ID)
+			if err != nil {
+				diags.Append(helpers.ParseUUIDErrorDiagnostic("Deployment SLA", err))
+				result = append(result, existing)
+
+				continue
+			}
+
+			if err := client.Update(ctx, deploymentID, slaID, payload); err != nil {
+				diags.Append(deploymentSLAReconcileErrorDiagnostic(sla.Name, "update", err))
+				result = append(result, existing)
+
+				continue
+			}
+			result = append(result, managedSLA{ID: existing.ID, Name: sla.Name, Duration: sla.Duration, Severity: sla.Severity})
+
+		default:
+			created, err := client.Create(ctx, deploymentID, payload)
+			if err != nil {
+				diags.Append(deploymentSLAReconcileErrorDiagnostic(sla.Name, "create", err))
+
+				continue
+			}
+			result = append(result, managedSLA{ID: created.ID.String(), Name: created.Name, Duration: created.Duration, Severity: created.Severity})
+		}
+	}
+
+	return result, diags
+}
+
+// deploymentSLAReconcileErrorDiagnostic returns an error diagnostic naming
+// the SLA that failed, so partial failures are easy to pinpoint.
+//
+//nolint:ireturn // required by Terraform API
+func deploymentSLAReconcileErrorDiagnostic(name string, operation string, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		fmt.Sprintf("Error during %s Deployment SLA %q", operation, name),
+		fmt.Sprintf("Could not %s Deployment SLA %q, unexpected error: %s. "+
+			"Other SLAs in this resource were still reconciled; run apply again once the issue is resolved.", operation, name, err.Error()),
+	)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *DeploymentSLAResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DeploymentSLAResourceModel
+
+	// Populate the model from resource configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, diags := managedSLAsFromModel(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.DeploymentSLAs(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Deployment SLA", err))
+
+		return
+	}
+
+	slas, diags := reconcileDeploymentSLAs(ctx, client, plan.DeploymentID.ValueUUID(), []managedSLA{}, desired)
+	resp.Diagnostics.Append(diags...)
+
+	plan.ID = types.StringValue(plan.DeploymentID.ValueString())
+	resp.Diagnostics.Append(copyManagedSLAsToModel(ctx, slas, &plan)...)
+
+	// Save whatever was created, even on partial failure,
+	// so those SLAs aren't orphaned outside of state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+// Managed SLAs that no longer exist are dropped, so they're created again,
+// and SLAs changed outside of Terraform show up as changes to revert.
+func (r *DeploymentSLAResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DeploymentSLAResourceModel
+
+	// Populate the model from state and emit diagnostics on error
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.DeploymentSLAs(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Deployment SLA", err))
+
+		return
+	}
+
+	current, diags := managedSLAsFromModel(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remote, err := client.List(ctx, state.DeploymentID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Deployment SLA", "list", err))
+
+		return
+	}
+
+	remoteByID := make(map[string]*api.DeploymentSLA, len(remote))
+	for _, sla := range remote {
+		remoteByID[sla.ID.String()] = sla
+	}
+
+	reconciled := make([]managedSLA, 0, len(current))
+	for _, managed := range current {
+		sla, ok := remoteByID[managed.ID]
+		if !ok {
+			continue
+		}
+
+		reconciled = append(reconciled, managedSLA{ID: managed.ID, Name: sla.Name, Duration: sla.Duration, Severity: sla.Severity})
+	}
+
+	resp.Diagnostics.Append(copyManagedSLAsToModel(ctx, reconciled, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *DeploymentSLAResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state DeploymentSLAResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, diags := managedSLAsFromModel(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	current, diags := managedSLAsFromModel(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.DeploymentSLAs(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Deployment SLA", err))
+
+		return
+	}
+
+	slas, diags := reconcileDeploymentSLAs(ctx, client, plan.DeploymentID.ValueUUID(), current, desired)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(copyManagedSLAsToModel(ctx, slas, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *DeploymentSLAResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DeploymentSLAResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, diags := managedSLAsFromModel(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.DeploymentSLAs(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Deployment SLA", err))
+
+		return
+	}
+
+	remaining, diags := reconcileDeploymentSLAs(ctx, client, state.DeploymentID.ValueUUID(), current, []managedSLA{})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		// Keep the SLAs that could not be deleted in state.
+		resp.Diagnostics.Append(copyManagedSLAsToModel(ctx, remaining, &state)...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+		return
+	}
+}
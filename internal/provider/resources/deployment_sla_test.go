@@ -0,0 +1,162 @@
+package resources_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccDeploymentSLAMock(endpoint, slas string) string {
+	return fmt.Sprintf(`
+provider "prefect" {
+	endpoint = "%s"
+}
+
+resource "prefect_deployment_sla" "test" {
+	deployment_id = "9d5c5d4a-2e9b-4b3a-9b4d-9e0f1a2b3c4d"
+	slas = [%s]
+}
+`, endpoint, slas)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_sla(t *testing.T) {
+	resourceName := "prefect_deployment_sla.test"
+	slasPath := "/deployments/9d5c5d4a-2e9b-4b3a-9b4d-9e0f1a2b3c4d/slas"
+
+	// The server keeps the SLAs of the deployment in memory.
+	slas := map[uuid.UUID]*api.DeploymentSLA{}
+	var mutex sync.Mutex
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, slasPath):
+			list := make([]*api.DeploymentSLA, 0, len(slas))
+			for _, sla := range slas {
+				list = append(list, sla)
+			}
+			_ = json.NewEncoder(w).Encode(list)
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, slasPath):
+			var payload api.DeploymentSLAUpsert
+			_ = json.NewDecoder(r.Body).Decode(&payload)
+			sla := &api.DeploymentSLA{Name: payload.Name, Duration: payload.Duration, Severity: payload.Severity}
+			sla.ID = uuid.New()
+			slas[sla.ID] = sla
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(sla)
+		case strings.Contains(r.URL.Path, slasPath+"/"):
+			slaID, err := uuid.Parse(r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:])
+			if err != nil || slas[slaID] == nil {
+				http.NotFound(w, r)
+
+				return
+			}
+
+			switch r.Method {
+			case http.MethodPatch:
+				var payload api.DeploymentSLAUpsert
+				_ = json.NewDecoder(r.Body).Decode(&payload)
+				slas[slaID].Name = payload.Name
+				slas[slaID].Duration = payload.Duration
+				slas[slaID].Severity = payload.Severity
+			case http.MethodDelete:
+				delete(slas, slaID)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	// checkServerSLAs checks the SLAs the server holds, by name.
+	checkServerSLAs := func(expected map[string]int64) resource.TestCheckFunc {
+		return func(_ *terraform.State) error {
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			if len(slas) != len(expected) {
+				return fmt.Errorf("expected %d SLAs on the server, got: %d", len(expected), len(slas))
+			}
+			for _, sla := range slas {
+				duration, ok := expected[sla.Name]
+				if !ok || duration != sla.Duration {
+					return fmt.Errorf("unexpected SLA %q with duration %d on the server", sla.Name, sla.Duration)
+				}
+			}
+
+			return nil
+		}
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Check creation of multiple SLAs
+				Config: fixtureAccDeploymentSLAMock(server.URL, `
+		{ name = "slow", duration = 600 },
+		{ name = "stuck", duration = 3600, severity = "critical" },
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkServerSLAs(map[string]int64{"slow": 600, "stuck": 3600}),
+					resource.TestCheckResourceAttr(resourceName, "slas.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "slas.0.name", "slow"),
+					resource.TestCheckResourceAttr(resourceName, "slas.0.severity", "high"),
+					resource.TestCheckResourceAttr(resourceName, "slas.1.severity", "critical"),
+					resource.TestCheckResourceAttr(resourceName, "sla_ids.%", "2"),
+				),
+			},
+			{
+				// Removing an SLA deletes it, and changing one updates it
+				Config: fixtureAccDeploymentSLAMock(server.URL, `
+		{ name = "slow", duration = 900 },
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkServerSLAs(map[string]int64{"slow": 900}),
+					resource.TestCheckResourceAttr(resourceName, "slas.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "slas.0.duration", "900"),
+					resource.TestCheckResourceAttr(resourceName, "sla_ids.%", "1"),
+				),
+			},
+			{
+				// An SLA deleted outside of Terraform is created again
+				PreConfig: func() {
+					mutex.Lock()
+					defer mutex.Unlock()
+
+					for slaID := range slas {
+						delete(slas, slaID)
+					}
+				},
+				Config: fixtureAccDeploymentSLAMock(server.URL, `
+		{ name = "slow", duration = 900 },
+`),
+				Check: checkServerSLAs(map[string]int64{"slow": 900}),
+			},
+			{
+				Config: fixtureAccDeploymentSLAMock(server.URL, `
+		{ name = "slow", duration = 900 },
+		{ name = "slow", duration = 1200 },
+`),
+				ExpectError: regexp.MustCompile("Duplicate SLA name"),
+			},
+		},
+	})
+}
@@ -2,13 +2,24 @@ package resources_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"regexp"
 	"strconv"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
@@ -27,6 +38,7 @@ type deploymentConfig struct {
 	Parameters             string
 	Path                   string
 	Paused                 bool
+	ReplaceOnVersionChange bool
 	Tags                   []string
 	Version                string
 	WorkPoolName           string
@@ -60,6 +72,7 @@ resource "prefect_deployment" "{{.DeploymentName}}" {
 	})
 	path = "{{.Path}}"
 	paused = {{.Paused}}
+	replace_on_version_change = {{.ReplaceOnVersionChange}}
 	tags = [{{range .Tags}}"{{.}}", {{end}}]
 	version = "{{.Version}}"
 	work_pool_name = "{{.WorkPoolName}}"
@@ -160,6 +173,7 @@ func TestAccResource_deployment(t *testing.T) {
 					resource.TestCheckResourceAttr(cfgCreate.DeploymentResourceName, "version", cfgCreate.Version),
 					resource.TestCheckResourceAttr(cfgCreate.DeploymentResourceName, "work_pool_name", cfgCreate.WorkPoolName),
 					resource.TestCheckResourceAttr(cfgCreate.DeploymentResourceName, "work_queue_name", cfgCreate.WorkQueueName),
+					resource.TestCheckResourceAttrSet(cfgCreate.DeploymentResourceName, "work_queue_id"),
 				),
 			},
 			{
@@ -198,6 +212,1708 @@ func TestAccResource_deployment(t *testing.T) {
 	})
 }
 
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_replace_on_version_change(t *testing.T) {
+	deploymentName := testutils.NewRandomPrefixedString()
+	flowName := testutils.NewRandomPrefixedString()
+
+	cfgCreate := deploymentConfig{
+		DeploymentName:         deploymentName,
+		FlowName:               flowName,
+		DeploymentResourceName: fmt.Sprintf("prefect_deployment.%s", deploymentName),
+		WorkspaceResourceName:  "data.prefect_workspace.evergreen",
+
+		Entrypoint:             "hello_world.py:hello_world",
+		Parameters:             "some-value1",
+		ReplaceOnVersionChange: true,
+		Version:                "v1.1.1",
+		WorkPoolName:           "evergreen-pool",
+		WorkQueueName:          "evergreen-queue",
+	}
+
+	cfgUpdate := cfgCreate
+	cfgUpdate.Version = "v1.1.2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccDeployment(cfgCreate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(cfgCreate.DeploymentResourceName, "replace_on_version_change", "true"),
+					resource.TestCheckResourceAttr(cfgCreate.DeploymentResourceName, "version", cfgCreate.Version),
+				),
+			},
+			{
+				// Check that a version bump replaces the deployment
+				Config: fixtureAccDeployment(cfgUpdate),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(cfgUpdate.DeploymentResourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(cfgUpdate.DeploymentResourceName, "version", cfgUpdate.Version),
+				),
+			},
+		},
+	})
+}
+
+func fixtureAccDeploymentInheritFlowTags(workspace, workspaceName, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "prefect_flow" "%s" {
+	name = "%s"
+	tags = ["flow-tag", "shared-tag"]
+	workspace_id = prefect_workspace.%s.id
+}
+
+resource "prefect_deployment" "%s" {
+	name = "%s"
+	flow_id = prefect_flow.%s.id
+	tags = ["deployment-tag", "shared-tag"]
+	inherit_flow_tags = true
+	workspace_id = prefect_workspace.%s.id
+}
+`, workspace, name, name, workspaceName, name, name, name, workspaceName)
+}
+
+func fixtureAccDeploymentWorkPool(workspace, workspaceName, name, workPoolName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "prefect_work_pool" "pool_a" {
+	name = "%s-a"
+	type = "process"
+	workspace_id = prefect_workspace.%s.id
+}
+
+resource "prefect_work_pool" "pool_b" {
+	name = "%s-b"
+	type = "process"
+	workspace_id = prefect_workspace.%s.id
+}
+
+resource "prefect_flow" "%s" {
+	name = "%s"
+	workspace_id = prefect_workspace.%s.id
+}
+
+resource "prefect_deployment" "%s" {
+	name = "%s"
+	flow_id = prefect_flow.%s.id
+	work_pool_name = prefect_work_pool.%s.name
+	workspace_id = prefect_workspace.%s.id
+}
+`, workspace,
+		name, workspaceName,
+		name, workspaceName,
+		name, name, workspaceName,
+		name, name, name, workPoolName, workspaceName)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_work_pool_change(t *testing.T) {
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+	randomName := testutils.NewRandomPrefixedString()
+	deploymentResourceName := "prefect_deployment." + randomName
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccDeploymentWorkPool(workspace, workspaceName, randomName, "pool_a"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(deploymentResourceName, "work_pool_name", randomName+"-a"),
+					resource.TestCheckResourceAttr(deploymentResourceName, "work_queue_name", "default"),
+				),
+			},
+			{
+				// Check that moving to another pool updates in place and
+				// picks up the new pool's default queue
+				Config: fixtureAccDeploymentWorkPool(workspace, workspaceName, randomName, "pool_b"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(deploymentResourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(deploymentResourceName, tfjsonpath.New("work_queue_name"), knownvalue.StringExact("default")),
+						plancheck.ExpectKnownValue(deploymentResourceName, tfjsonpath.New("work_queue_id"), knownvalue.NotNull()),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(deploymentResourceName, "work_pool_name", randomName+"-b"),
+					resource.TestCheckResourceAttr(deploymentResourceName, "work_queue_name", "default"),
+					resource.TestCheckResourceAttrSet(deploymentResourceName, "work_queue_id"),
+				),
+			},
+		},
+	})
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_inherit_flow_tags(t *testing.T) {
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+	randomName := testutils.NewRandomPrefixedString()
+	deploymentResourceName := "prefect_deployment." + randomName
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check that the flow's tags are merged into tags_all and de-duplicated,
+				// while tags stays as configured
+				Config: fixtureAccDeploymentInheritFlowTags(workspace, workspaceName, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(deploymentResourceName, "inherit_flow_tags", "true"),
+					resource.TestCheckResourceAttr(deploymentResourceName, "tags.#", "2"),
+					resource.TestCheckResourceAttr(deploymentResourceName, "tags_all.#", "3"),
+					resource.TestCheckResourceAttr(deploymentResourceName, "tags_all.0", "deployment-tag"),
+					resource.TestCheckResourceAttr(deploymentResourceName, "tags_all.1", "shared-tag"),
+					resource.TestCheckResourceAttr(deploymentResourceName, "tags_all.2", "flow-tag"),
+				),
+			},
+			{
+				// Check that the merged tags don't show up as a change on the next plan
+				Config: fixtureAccDeploymentInheritFlowTags(workspace, workspaceName, randomName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_partial_update(t *testing.T) {
+	deploymentName := testutils.NewRandomPrefixedString()
+	flowName := testutils.NewRandomPrefixedString()
+
+	cfgCreate := deploymentConfig{
+		DeploymentName:         deploymentName,
+		FlowName:               flowName,
+		DeploymentResourceName: fmt.Sprintf("prefect_deployment.%s", deploymentName),
+		WorkspaceResourceName:  "data.prefect_workspace.evergreen",
+
+		Description:   "My deployment description",
+		Entrypoint:    "hello_world.py:hello_world",
+		ManifestPath:  "some-manifest-path",
+		Parameters:    "some-value1",
+		Path:          "some-path",
+		Tags:          []string{"test1"},
+		Version:       "v1.1.1",
+		WorkPoolName:  "evergreen-pool",
+		WorkQueueName: "evergreen-queue",
+	}
+
+	cfgUpdate := cfgCreate
+	cfgUpdate.Description = "My deployment description v2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccDeployment(cfgCreate),
+			},
+			{
+				// Check that re-applying the same configuration is a no-op
+				Config: fixtureAccDeployment(cfgCreate),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				// Check that changing only the description leaves everything else untouched
+				Config: fixtureAccDeployment(cfgUpdate),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(cfgUpdate.DeploymentResourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(cfgUpdate.DeploymentResourceName, tfjsonpath.New("description"), knownvalue.StringExact(cfgUpdate.Description)),
+						plancheck.ExpectKnownValue(cfgUpdate.DeploymentResourceName, tfjsonpath.New("manifest_path"), knownvalue.StringExact(cfgCreate.ManifestPath)),
+						plancheck.ExpectKnownValue(cfgUpdate.DeploymentResourceName, tfjsonpath.New("path"), knownvalue.StringExact(cfgCreate.Path)),
+						plancheck.ExpectKnownValue(cfgUpdate.DeploymentResourceName, tfjsonpath.New("work_queue_name"), knownvalue.StringExact(cfgCreate.WorkQueueName)),
+						plancheck.ExpectKnownValue(cfgUpdate.DeploymentResourceName, tfjsonpath.New("work_queue_id"), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue(cfgUpdate.DeploymentResourceName, tfjsonpath.New("created"), knownvalue.NotNull()),
+						plancheck.ExpectUnknownValue(cfgUpdate.DeploymentResourceName, tfjsonpath.New("updated")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(cfgUpdate.DeploymentResourceName, "description", cfgUpdate.Description),
+					resource.TestCheckResourceAttr(cfgUpdate.DeploymentResourceName, "manifest_path", cfgCreate.ManifestPath),
+					resource.TestCheckResourceAttr(cfgUpdate.DeploymentResourceName, "parameters", `{"some-parameter":"some-value1"}`),
+					resource.TestCheckResourceAttr(cfgUpdate.DeploymentResourceName, "version", cfgCreate.Version),
+				),
+			},
+		},
+	})
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_path_drift(t *testing.T) {
+	deploymentName := testutils.NewRandomPrefixedString()
+	flowName := testutils.NewRandomPrefixedString()
+
+	cfg := deploymentConfig{
+		DeploymentName:         deploymentName,
+		FlowName:               flowName,
+		DeploymentResourceName: fmt.Sprintf("prefect_deployment.%s", deploymentName),
+		WorkspaceResourceName:  "data.prefect_workspace.evergreen",
+
+		Entrypoint:    "hello_world.py:hello_world",
+		ManifestPath:  "some-manifest-path",
+		Parameters:    "some-value1",
+		Path:          "some-path",
+		WorkPoolName:  "evergreen-pool",
+		WorkQueueName: "evergreen-queue",
+	}
+
+	var deployment api.Deployment
+	var workspaceID uuid.UUID
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccDeployment(cfg),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(cfg.DeploymentResourceName, cfg.WorkspaceResourceName, &deployment),
+					func(s *terraform.State) error {
+						workspaceID, _ = uuid.Parse(s.RootModule().Resources[cfg.WorkspaceResourceName].Primary.ID)
+
+						return nil
+					},
+				),
+			},
+			{
+				// Change the storage paths outside of Terraform, as a CLI redeploy would,
+				// and check that the drift shows up as a planned update
+				PreConfig: func() {
+					c, _ := testutils.NewTestClient()
+					deploymentsClient, _ := c.Deployments(uuid.Nil, workspaceID)
+
+					manifestPath := "other-manifest-path"
+					path := "other-path"
+					err := deploymentsClient.Update(context.Background(), deployment.ID, api.DeploymentUpdate{
+						ManifestPath: &manifestPath,
+						Path:         &path,
+					})
+					if err != nil {
+						t.Fatalf("error updating deployment out of band: %s", err)
+					}
+				},
+				Config: fixtureAccDeployment(cfg),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(cfg.DeploymentResourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(cfg.DeploymentResourceName, tfjsonpath.New("manifest_path"), knownvalue.StringExact(cfg.ManifestPath)),
+						plancheck.ExpectKnownValue(cfg.DeploymentResourceName, tfjsonpath.New("path"), knownvalue.StringExact(cfg.Path)),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(cfg.DeploymentResourceName, "manifest_path", cfg.ManifestPath),
+					resource.TestCheckResourceAttr(cfg.DeploymentResourceName, "path", cfg.Path),
+				),
+			},
+		},
+	})
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_tags_drift(t *testing.T) {
+	deploymentName := testutils.NewRandomPrefixedString()
+	flowName := testutils.NewRandomPrefixedString()
+
+	cfg := deploymentConfig{
+		DeploymentName:         deploymentName,
+		FlowName:               flowName,
+		DeploymentResourceName: fmt.Sprintf("prefect_deployment.%s", deploymentName),
+		WorkspaceResourceName:  "data.prefect_workspace.evergreen",
+
+		Entrypoint:    "hello_world.py:hello_world",
+		ManifestPath:  "some-manifest-path",
+		Parameters:    "some-value1",
+		Path:          "some-path",
+		Tags:          []string{"test1", "test2", "test3"},
+		WorkPoolName:  "evergreen-pool",
+		WorkQueueName: "evergreen-queue",
+	}
+
+	var deployment api.Deployment
+	var workspaceID uuid.UUID
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccDeployment(cfg),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(cfg.DeploymentResourceName, cfg.WorkspaceResourceName, &deployment),
+					resource.TestCheckResourceAttr(cfg.DeploymentResourceName, "tags.#", "3"),
+					func(s *terraform.State) error {
+						workspaceID, _ = uuid.Parse(s.RootModule().Resources[cfg.WorkspaceResourceName].Primary.ID)
+
+						return nil
+					},
+				),
+			},
+			{
+				// Remove a tag outside of Terraform, as in the UI, and check that
+				// the missing tag is planned to be added back
+				PreConfig: func() {
+					c, _ := testutils.NewTestClient()
+					deploymentsClient, _ := c.Deployments(uuid.Nil, workspaceID)
+
+					tags := []string{"test1", "test3"}
+					err := deploymentsClient.Update(context.Background(), deployment.ID, api.DeploymentUpdate{
+						Tags: &tags,
+					})
+					if err != nil {
+						t.Fatalf("error updating deployment out of band: %s", err)
+					}
+				},
+				Config: fixtureAccDeployment(cfg),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(cfg.DeploymentResourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(cfg.DeploymentResourceName, tfjsonpath.New("tags"), knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact("test1"),
+							knownvalue.StringExact("test2"),
+							knownvalue.StringExact("test3"),
+						})),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(cfg.DeploymentResourceName, cfg.WorkspaceResourceName, &deployment),
+					resource.TestCheckResourceAttr(cfg.DeploymentResourceName, "tags.#", "3"),
+					resource.TestCheckResourceAttr(cfg.DeploymentResourceName, "tags.1", "test2"),
+					func(_ *terraform.State) error {
+						if len(deployment.Tags) != 3 {
+							return fmt.Errorf("expected the deployment to have 3 tags again, got %v", deployment.Tags)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func fixtureAccDeploymentJobVariables(workspace, workspaceName, name, image string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "prefect_flow" "%s" {
+	name = "%s"
+	workspace_id = prefect_workspace.%s.id
+}
+
+resource "prefect_deployment" "%s" {
+	name = "%s"
+	flow_id = prefect_flow.%s.id
+	job_variables = jsonencode({
+		"image" = "%s"
+		"env" = {
+			"PREFECT_LOGGING_LEVEL" = "DEBUG"
+			"EXTRA_PIP_PACKAGES" = "pandas"
+		}
+	})
+	workspace_id = prefect_workspace.%s.id
+}
+`, workspace, name, name, workspaceName, name, name, name, image, workspaceName)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_job_variables(t *testing.T) {
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+	randomName := testutils.NewRandomPrefixedString()
+	deploymentResourceName := "prefect_deployment." + randomName
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccDeploymentJobVariables(workspace, workspaceName, randomName, "prefecthq/prefect:3-latest"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(deploymentResourceName, "job_variables", `{"env":{"EXTRA_PIP_PACKAGES":"pandas","PREFECT_LOGGING_LEVEL":"DEBUG"},"image":"prefecthq/prefect:3-latest"}`),
+				),
+			},
+			{
+				// Check that re-applying the same configuration doesn't drift
+				Config: fixtureAccDeploymentJobVariables(workspace, workspaceName, randomName, "prefecthq/prefect:3-latest"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				// Check that changing a nested value updates in place
+				Config: fixtureAccDeploymentJobVariables(workspace, workspaceName, randomName, "prefecthq/prefect:3-python3.12"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(deploymentResourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(deploymentResourceName, "job_variables", `{"env":{"EXTRA_PIP_PACKAGES":"pandas","PREFECT_LOGGING_LEVEL":"DEBUG"},"image":"prefecthq/prefect:3-python3.12"}`),
+				),
+			},
+		},
+	})
+}
+
+func fixtureAccDeploymentPullSteps(workspace, workspaceName, name, branch string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "prefect_flow" "%s" {
+	name = "%s"
+	workspace_id = prefect_workspace.%s.id
+}
+
+resource "prefect_deployment" "%s" {
+	name = "%s"
+	flow_id = prefect_flow.%s.id
+	pull_steps = jsonencode([
+		{
+			"prefect.deployments.steps.git_clone" = {
+				"repository" = "https://github.com/PrefectHQ/prefect.git"
+				"branch" = "%s"
+			}
+		},
+		{
+			"prefect.deployments.steps.set_working_directory" = {
+				"directory" = "/opt/prefect"
+			}
+		}
+	])
+	workspace_id = prefect_workspace.%s.id
+}
+`, workspace, name, name, workspaceName, name, name, name, branch, workspaceName)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_pull_steps(t *testing.T) {
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+	randomName := testutils.NewRandomPrefixedString()
+	deploymentResourceName := "prefect_deployment." + randomName
+
+	pullSteps := func(branch string) string {
+		return fmt.Sprintf(`[{"prefect.deployments.steps.git_clone":{"branch":%q,"repository":"https://github.com/PrefectHQ/prefect.git"}},{"prefect.deployments.steps.set_working_directory":{"directory":"/opt/prefect"}}]`, branch)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccDeploymentPullSteps(workspace, workspaceName, randomName, "main"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(deploymentResourceName, "pull_steps", pullSteps("main")),
+				),
+			},
+			{
+				// Check that re-applying the same configuration doesn't drift
+				Config: fixtureAccDeploymentPullSteps(workspace, workspaceName, randomName, "main"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				// Check that changing a step updates in place
+				Config: fixtureAccDeploymentPullSteps(workspace, workspaceName, randomName, "develop"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(deploymentResourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(deploymentResourceName, "pull_steps", pullSteps("develop")),
+				),
+			},
+		},
+	})
+}
+
+func fixtureAccDeploymentConcurrency(workspace, workspaceName, name, concurrency string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "prefect_flow" "%s" {
+	name = "%s"
+	workspace_id = prefect_workspace.%s.id
+}
+
+resource "prefect_deployment" "%s" {
+	name = "%s"
+	flow_id = prefect_flow.%s.id
+	%s
+	workspace_id = prefect_workspace.%s.id
+}
+`, workspace, name, name, workspaceName, name, name, name, concurrency, workspaceName)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_concurrency(t *testing.T) {
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+	randomName := testutils.NewRandomPrefixedString()
+	deploymentResourceName := "prefect_deployment." + randomName
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check that concurrency_options can't be set without a limit
+				Config: fixtureAccDeploymentConcurrency(workspace, workspaceName, randomName, `
+	concurrency_options = {
+		collision_strategy = "ENQUEUE"
+	}`),
+				ExpectError: regexp.MustCompile("Missing concurrency_limit"),
+			},
+			{
+				Config: fixtureAccDeploymentConcurrency(workspace, workspaceName, randomName, `
+	concurrency_limit = 2
+	concurrency_options = {
+		collision_strategy = "ENQUEUE"
+	}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(deploymentResourceName, "concurrency_limit", "2"),
+					resource.TestCheckResourceAttr(deploymentResourceName, "concurrency_options.collision_strategy", "ENQUEUE"),
+				),
+			},
+			{
+				Config: fixtureAccDeploymentConcurrency(workspace, workspaceName, randomName, `
+	concurrency_limit = 1
+	concurrency_options = {
+		collision_strategy = "CANCEL_NEW"
+	}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(deploymentResourceName, "concurrency_limit", "1"),
+					resource.TestCheckResourceAttr(deploymentResourceName, "concurrency_options.collision_strategy", "CANCEL_NEW"),
+				),
+			},
+			{
+				// Check that removing the limit clears it server-side
+				Config: fixtureAccDeploymentConcurrency(workspace, workspaceName, randomName, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(deploymentResourceName, "concurrency_limit"),
+				),
+			},
+		},
+	})
+}
+
+func fixtureAccDeploymentVersionInfoFromEnv(workspace, workspaceName, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "prefect_flow" "%s" {
+	name = "%s"
+	workspace_id = prefect_workspace.%s.id
+}
+
+resource "prefect_deployment" "%s" {
+	name = "%s"
+	flow_id = prefect_flow.%s.id
+	version_info = {
+		branch = "release"
+	}
+	version_info_from_env = true
+	workspace_id = prefect_workspace.%s.id
+}
+`, workspace, name, name, workspaceName, name, name, name, workspaceName)
+}
+
+// This test sets environment variables, so it can't run in parallel.
+//
+//nolint:paralleltest // t.Setenv can't be used with the resource.ParallelTest helper
+func TestAccResource_deployment_version_info_from_env(t *testing.T) {
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+	randomName := testutils.NewRandomPrefixedString()
+	deploymentResourceName := "prefect_deployment." + randomName
+
+	t.Setenv("GIT_COMMIT", "0123456789abcdef0123456789abcdef01234567")
+	t.Setenv("GIT_BRANCH", "main")
+	t.Setenv("GIT_URL", "https://github.com/PrefectHQ/terraform-provider-prefect")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check that unset values come from the environment,
+				// while the explicit branch is kept
+				Config: fixtureAccDeploymentVersionInfoFromEnv(workspace, workspaceName, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(deploymentResourceName, "version_info.commit", "0123456789abcdef0123456789abcdef01234567"),
+					resource.TestCheckResourceAttr(deploymentResourceName, "version_info.branch", "release"),
+					resource.TestCheckResourceAttr(deploymentResourceName, "version_info.url", "https://github.com/PrefectHQ/terraform-provider-prefect"),
+				),
+			},
+			{
+				// Check that a new commit in the environment updates the deployment
+				PreConfig: func() {
+					t.Setenv("GIT_COMMIT", "89abcdef0123456789abcdef0123456789abcdef")
+				},
+				Config: fixtureAccDeploymentVersionInfoFromEnv(workspace, workspaceName, randomName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(deploymentResourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(deploymentResourceName, "version_info.commit", "89abcdef0123456789abcdef0123456789abcdef"),
+					resource.TestCheckResourceAttr(deploymentResourceName, "version_info.branch", "release"),
+				),
+			},
+		},
+	})
+}
+
+func fixtureAccDeploymentDefaultPaused(workspaceID, name string, defaultPaused bool) string {
+	return fmt.Sprintf(`
+provider "prefect" {
+	default_paused_by_workspace = {
+		"%s" = %t
+	}
+}
+
+resource "prefect_flow" "%s" {
+	name = "%s"
+	workspace_id = "%s"
+}
+
+resource "prefect_deployment" "%s_default" {
+	name = "%s-default"
+	flow_id = prefect_flow.%s.id
+	workspace_id = "%s"
+}
+
+resource "prefect_deployment" "%s_explicit" {
+	name = "%s-explicit"
+	flow_id = prefect_flow.%s.id
+	paused = false
+	workspace_id = "%s"
+}
+`, workspaceID, defaultPaused, name, name, workspaceID, name, name, name, workspaceID, name, name, name, workspaceID)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_default_paused(t *testing.T) {
+	// The provider is configured before any resources are created, so the
+	// workspace has to exist up front for its ID to be known.
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", resource.EnvTfAcc)
+	}
+	testutils.AccTestPreCheck(t)
+
+	randomName := testutils.NewRandomPrefixedString()
+	defaultDeploymentResourceName := "prefect_deployment." + randomName + "_default"
+	explicitDeploymentResourceName := "prefect_deployment." + randomName + "_explicit"
+
+	c, _ := testutils.NewTestClient()
+	workspacesClient, _ := c.Workspaces(uuid.Nil)
+	workspace, err := workspacesClient.Create(context.Background(), api.WorkspaceCreate{
+		Name:   randomName,
+		Handle: randomName,
+	})
+	if err != nil {
+		t.Fatalf("error creating workspace: %s", err)
+	}
+	t.Cleanup(func() {
+		_ = workspacesClient.Delete(context.Background(), workspace.ID)
+	})
+	workspaceID := workspace.ID.String()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check that the workspace's default applies only when paused is unset
+				Config: fixtureAccDeploymentDefaultPaused(workspaceID, randomName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(defaultDeploymentResourceName, "paused", "true"),
+					resource.TestCheckResourceAttr(explicitDeploymentResourceName, "paused", "false"),
+				),
+			},
+			{
+				// Check that changing the workspace's default updates the deployment
+				Config: fixtureAccDeploymentDefaultPaused(workspaceID, randomName, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(defaultDeploymentResourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction(explicitDeploymentResourceName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(defaultDeploymentResourceName, "paused", "false"),
+					resource.TestCheckResourceAttr(explicitDeploymentResourceName, "paused", "false"),
+				),
+			},
+		},
+	})
+}
+
+func fixtureAccDeploymentEnforceParameterSchema(workspace, workspaceName, name string, enforceWhenProvided bool) string {
+	return fmt.Sprintf(`
+provider "prefect" {
+	enforce_parameter_schema_when_provided = %t
+}
+
+%s
+
+resource "prefect_flow" "%s" {
+	name = "%s"
+	workspace_id = prefect_workspace.%s.id
+}
+
+resource "prefect_deployment" "%s_with_schema" {
+	name = "%s-with-schema"
+	flow_id = prefect_flow.%s.id
+	parameter_openapi_schema = jsonencode({
+		type = "object"
+		title = "Parameters"
+		properties = {
+			name = { type = "string", title = "name" }
+		}
+		required = ["name"]
+	})
+	workspace_id = prefect_workspace.%s.id
+}
+
+resource "prefect_deployment" "%s_without_schema" {
+	name = "%s-without-schema"
+	flow_id = prefect_flow.%s.id
+	workspace_id = prefect_workspace.%s.id
+}
+`, enforceWhenProvided, workspace, name, name, workspaceName, name, name, name, workspaceName, name, name, name, workspaceName)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_enforce_parameter_schema_default(t *testing.T) {
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+	randomName := testutils.NewRandomPrefixedString()
+	withSchemaResourceName := "prefect_deployment." + randomName + "_with_schema"
+	withoutSchemaResourceName := "prefect_deployment." + randomName + "_without_schema"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check that with the flag on, only the deployment providing a schema enforces it
+				Config: fixtureAccDeploymentEnforceParameterSchema(workspace, workspaceName, randomName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(withSchemaResourceName, "enforce_parameter_schema", "true"),
+					resource.TestCheckResourceAttrSet(withSchemaResourceName, "parameter_openapi_schema"),
+					resource.TestCheckResourceAttr(withoutSchemaResourceName, "enforce_parameter_schema", "false"),
+				),
+			},
+			{
+				// Check that with the flag off, the default is false again
+				Config: fixtureAccDeploymentEnforceParameterSchema(workspace, workspaceName, randomName, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(withSchemaResourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction(withoutSchemaResourceName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(withSchemaResourceName, "enforce_parameter_schema", "false"),
+					resource.TestCheckResourceAttr(withoutSchemaResourceName, "enforce_parameter_schema", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestParameterSchemaValidationHelper(t *testing.T) {
+	t.Parallel()
+
+	var parameterSchema map[string]interface{}
+	err := json.Unmarshal([]byte(`{
+		"type": "object",
+		"properties": {
+			"name": {"type": "string"},
+			"config": {"$ref": "#/definitions/Config"},
+			"regions": {"type": "array", "items": {"type": "string"}},
+			"endpoints": {"type": "array", "items": {"$ref": "#/definitions/Config"}},
+			"pair": {"type": "array", "prefixItems": [{"type": "string"}, {"type": "integer"}]}
+		},
+		"required": ["name"],
+		"definitions": {
+			"Config": {
+				"type": "object",
+				"properties": {
+					"region": {"type": "string"},
+					"retries": {"type": "integer"}
+				},
+				"required": ["region"]
+			}
+		}
+	}`), &parameterSchema)
+	if err != nil {
+		t.Fatalf("error decoding parameter schema: %s", err)
+	}
+
+	cases := []struct {
+		parameters string
+		want       []string
+	}{
+		{`{"name": "x", "config": {"region": "us-east-1", "retries": 3}}`, nil},
+		{`{"config": {"region": "us-east-1"}}`, []string{"parameters.name"}},
+		{`{"name": "x", "config": {"retries": 3}}`, []string{"parameters.config.region"}},
+		{`{"name": "x", "config": {"region": 1, "retries": 1.5}}`, []string{"parameters.config.region", "parameters.config.retries"}},
+		{`{"name": "x", "config": "us-east-1"}`, []string{"parameters.config"}},
+		{`{"name": "x", "confg": {"region": "us-east-1"}}`, []string{"parameters.confg"}},
+		{`{"name": "x", "config": {"region": "us-east-1", "retires": 3}}`, nil},
+		{`{"name": "x", "regions": ["us-east-1", "eu-west-1"], "endpoints": [{"region": "us-east-1"}], "pair": ["a", 1]}`, nil},
+		{`{"name": "x", "regions": []}`, nil},
+		{`{"name": "x", "regions": ["us-east-1", "eu-west-1", 3]}`, []string{"parameters.regions[2]"}},
+		{`{"name": "x", "regions": "us-east-1"}`, []string{"parameters.regions"}},
+		{`{"name": "x", "endpoints": [{"region": "us-east-1"}, {"retries": 1}]}`, []string{"parameters.endpoints[1].region"}},
+		{`{"name": "x", "pair": [1, "a"]}`, []string{"parameters.pair[0]", "parameters.pair[1]"}},
+	}
+
+	for _, c := range cases {
+		var parameters map[string]interface{}
+		if err := json.Unmarshal([]byte(c.parameters), &parameters); err != nil {
+			t.Fatalf("error decoding parameters: %s", err)
+		}
+
+		var got []string
+		for _, violation := range helpers.ValidateParameters(parameterSchema, parameters) {
+			got = append(got, violation.Path)
+		}
+		if fmt.Sprint(got) != fmt.Sprint(c.want) {
+			t.Fatalf("parameters %s should have violations at %v, but got %v", c.parameters, c.want, got)
+		}
+	}
+
+	// Unknown keys are accepted when the flow takes arbitrary keyword
+	// arguments, and rejected in nested objects that forbid them.
+	parameterSchema["additionalProperties"] = true
+	parameterSchema["definitions"].(map[string]interface{})["Config"].(map[string]interface{})["additionalProperties"] = false
+
+	var parameters map[string]interface{}
+	_ = json.Unmarshal([]byte(`{"name": "x", "extra": 1, "config": {"region": "us-east-1", "retires": 3}}`), &parameters)
+
+	var got []string
+	for _, violation := range helpers.ValidateParameters(parameterSchema, parameters) {
+		got = append(got, violation.Path)
+	}
+	if want := []string{"parameters.config.retires"}; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("parameters should have violations at %v, but got %v", want, got)
+	}
+}
+
+func fixtureAccDeploymentInferParameterSchema(workspace, workspaceName, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "prefect_flow" "%s" {
+	name = "%s"
+	workspace_id = prefect_workspace.%s.id
+}
+
+resource "prefect_deployment" "%s" {
+	name = "%s"
+	flow_id = prefect_flow.%s.id
+	infer_parameter_schema = true
+	parameters = jsonencode({
+		name = "x"
+		retries = 3
+		ratio = 0.5
+		enabled = true
+		regions = ["us-east-1"]
+		config = { region = "us-east-1" }
+	})
+	workspace_id = prefect_workspace.%s.id
+}
+`, workspace, name, name, workspaceName, name, name, name, workspaceName)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_infer_parameter_schema(t *testing.T) {
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+	randomName := testutils.NewRandomPrefixedString()
+	resourceName := "prefect_deployment." + randomName
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check that the schema is inferred from the parameter types, and enforced
+				Config: fixtureAccDeploymentInferParameterSchema(workspace, workspaceName, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "infer_parameter_schema", "true"),
+					resource.TestCheckResourceAttr(resourceName, "enforce_parameter_schema", "true"),
+					resource.TestCheckResourceAttrWith(resourceName, "parameter_openapi_schema", func(value string) error {
+						var parameterSchema struct {
+							Properties map[string]struct {
+								Type string `json:"type"`
+							} `json:"properties"`
+							Required []string `json:"required"`
+						}
+						if err := json.Unmarshal([]byte(value), &parameterSchema); err != nil {
+							return fmt.Errorf("error decoding parameter schema: %w", err)
+						}
+
+						want := map[string]string{
+							"name":    "string",
+							"retries": "integer",
+							"ratio":   "number",
+							"enabled": "boolean",
+							"regions": "array",
+							"config":  "object",
+						}
+						if len(parameterSchema.Properties) != len(want) {
+							return fmt.Errorf("expected %d parameters in the schema, got %d", len(want), len(parameterSchema.Properties))
+						}
+						for name, typ := range want {
+							if got := parameterSchema.Properties[name].Type; got != typ {
+								return fmt.Errorf("expected parameter %s to be of type %s, got %q", name, typ, got)
+							}
+						}
+						if len(parameterSchema.Required) != 0 {
+							return fmt.Errorf("expected no required parameters, got %v", parameterSchema.Required)
+						}
+
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestInferParameterSchemaHelper(t *testing.T) {
+	t.Parallel()
+
+	var parameters map[string]interface{}
+	err := json.Unmarshal([]byte(`{"name": "x", "retries": 3, "ratio": 0.5, "enabled": false, "regions": ["us-east-1"], "config": {"region": "us-east-1"}, "unset": null}`), &parameters)
+	if err != nil {
+		t.Fatalf("error decoding parameters: %s", err)
+	}
+
+	parameterSchema := helpers.InferParameterSchema(parameters)
+	if _, ok := parameterSchema["required"]; ok {
+		t.Fatalf("inferred schema should not require any parameters, but got %v", parameterSchema["required"])
+	}
+
+	properties, _ := parameterSchema["properties"].(map[string]interface{})
+	want := map[string]interface{}{
+		"name":    "string",
+		"retries": "integer",
+		"ratio":   "number",
+		"enabled": "boolean",
+		"regions": "array",
+		"config":  "object",
+		"unset":   nil,
+	}
+	if len(properties) != len(want) {
+		t.Fatalf("inferred schema should have %d parameters, but got %d", len(want), len(properties))
+	}
+	for name, typ := range want {
+		property, _ := properties[name].(map[string]interface{})
+		if property["type"] != typ {
+			t.Fatalf("parameter %s should be of type %v, but got %v", name, typ, property["type"])
+		}
+	}
+
+	// The inferred schema accepts the parameters it was inferred from.
+	if violations := helpers.ValidateParameters(parameterSchema, parameters); len(violations) != 0 {
+		t.Fatalf("parameters should match the inferred schema, but got violations %v", violations)
+	}
+
+	// An empty schema is inferred without parameters.
+	if properties, _ := helpers.InferParameterSchema(nil)["properties"].(map[string]interface{}); len(properties) != 0 {
+		t.Fatalf("inferred schema should have no parameters, but got %v", properties)
+	}
+}
+
+func fixtureAccDeploymentNestedParameterSchema(workspace, workspaceName, name, config string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "prefect_flow" "%s" {
+	name = "%s"
+	workspace_id = prefect_workspace.%s.id
+}
+
+resource "prefect_deployment" "%s" {
+	name = "%s"
+	flow_id = prefect_flow.%s.id
+	enforce_parameter_schema = true
+	parameter_openapi_schema = jsonencode({
+		type = "object"
+		title = "Parameters"
+		properties = {
+			config = {
+				type = "object"
+				properties = {
+					region = { type = "string" }
+					retries = { type = "integer" }
+				}
+				required = ["region"]
+			}
+		}
+	})
+	parameters = jsonencode({
+		config = %s
+	})
+	workspace_id = prefect_workspace.%s.id
+}
+`, workspace, name, name, workspaceName, name, name, name, config, workspaceName)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_nested_parameter_schema(t *testing.T) {
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+	randomName := testutils.NewRandomPrefixedString()
+	deploymentResourceName := "prefect_deployment." + randomName
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check that a missing nested required parameter is reported with its path
+				Config:      fixtureAccDeploymentNestedParameterSchema(workspace, workspaceName, randomName, `{ retries = 3 }`),
+				ExpectError: regexp.MustCompile("parameters.config.region"),
+			},
+			{
+				// Check that a nested type mismatch is reported with its path
+				Config:      fixtureAccDeploymentNestedParameterSchema(workspace, workspaceName, randomName, `{ region = "us-east-1", retries = "three" }`),
+				ExpectError: regexp.MustCompile("parameters.config.retries"),
+			},
+			{
+				// Check that a parameter missing from the schema, e.g. a typo, is reported with its path
+				Config:      fixtureAccDeploymentNestedParameterSchema(workspace, workspaceName, randomName, `{ region = "us-east-1" }, confg = {}`),
+				ExpectError: regexp.MustCompile("parameters.confg"),
+			},
+			{
+				Config: fixtureAccDeploymentNestedParameterSchema(workspace, workspaceName, randomName, `{ region = "us-east-1", retries = 3 }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(deploymentResourceName, "parameters", `{"config":{"region":"us-east-1","retries":3}}`),
+				),
+			},
+		},
+	})
+}
+
+func fixtureAccDeploymentArrayParameterSchema(workspace, workspaceName, name string, enforce bool, regions string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "prefect_flow" "%s" {
+	name = "%s"
+	workspace_id = prefect_workspace.%s.id
+}
+
+resource "prefect_deployment" "%s" {
+	name = "%s"
+	flow_id = prefect_flow.%s.id
+	enforce_parameter_schema = %t
+	parameter_openapi_schema = jsonencode({
+		type = "object"
+		title = "Parameters"
+		properties = {
+			regions = {
+				type = "array"
+				items = { type = "string" }
+			}
+		}
+	})
+	parameters = jsonencode({
+		regions = %s
+	})
+	workspace_id = prefect_workspace.%s.id
+}
+`, workspace, name, name, workspaceName, name, name, name, enforce, regions, workspaceName)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_array_parameter_schema(t *testing.T) {
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+	randomName := testutils.NewRandomPrefixedString()
+	deploymentResourceName := "prefect_deployment." + randomName
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check that a mismatching element is reported with its index
+				Config:      fixtureAccDeploymentArrayParameterSchema(workspace, workspaceName, randomName, true, `["us-east-1", "eu-west-1", 3]`),
+				ExpectError: regexp.MustCompile(`parameters.regions\[2\]`),
+			},
+			{
+				Config: fixtureAccDeploymentArrayParameterSchema(workspace, workspaceName, randomName, true, `["us-east-1", "eu-west-1"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(deploymentResourceName, "parameters", `{"regions":["us-east-1","eu-west-1"]}`),
+				),
+			},
+			{
+				// Check that elements aren't validated when the schema isn't enforced
+				Config: fixtureAccDeploymentArrayParameterSchema(workspace, workspaceName, randomName, false, `["us-east-1", 3]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(deploymentResourceName, "parameters", `{"regions":["us-east-1",3]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestVersionAtLeastHelper(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		version string
+		want    bool
+		wantOK  bool
+	}{
+		{"3.1.0", true, true},
+		{"3.1.5", true, true},
+		{"3.10.0", true, true},
+		{"4.0", true, true},
+		{"3.0.11", false, true},
+		{"2.20.3", false, true},
+		{"3.1.0.dev2", true, true},
+		{"3.1rc1", true, true},
+		{"3.0.0+12.gabcdef", false, true},
+		{"unknown", false, false},
+	}
+
+	for _, c := range cases {
+		got, ok := helpers.IsVersionAtLeast(c.version, "3.1.0")
+		if got != c.want || ok != c.wantOK {
+			t.Fatalf("version %q should be at least 3.1.0: %t (parsed: %t), but got %t (parsed: %t)", c.version, c.want, c.wantOK, got, ok)
+		}
+	}
+}
+
+func fixtureAccDeploymentConcurrencyOldServer(endpoint, name string) string {
+	return fmt.Sprintf(`
+provider "prefect" {
+	endpoint = "%s"
+}
+
+resource "prefect_deployment" "%s" {
+	name = "%s"
+	flow_id = "00000000-0000-0000-0000-000000000000"
+	concurrency_limit = 1
+}
+`, endpoint, name, name)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_concurrency_old_server(t *testing.T) {
+	randomName := testutils.NewRandomPrefixedString()
+
+	// The server only reports its version, as a Prefect server that
+	// predates deployment concurrency would.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/admin/version" {
+			http.NotFound(w, r)
+
+			return
+		}
+		_, _ = w.Write([]byte(`"2.20.3"`))
+	}))
+	defer server.Close()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check that concurrency_limit is rejected rather than silently ignored
+				Config:      fixtureAccDeploymentConcurrencyOldServer(server.URL, randomName),
+				ExpectError: regexp.MustCompile(`Unsupported by the Prefect server version`),
+			},
+		},
+	})
+}
+
+func fixtureAccDeploymentPoolConcurrency(workspace, workspaceName, name string, withDeployment bool) string {
+	deployment := ""
+	if withDeployment {
+		deployment = fmt.Sprintf(`
+resource "prefect_deployment" "%s" {
+	name = "%s"
+	flow_id = prefect_flow.%s.id
+	work_pool_name = prefect_work_pool.%s.name
+	concurrency_limit = 5
+	workspace_id = prefect_workspace.%s.id
+}
+`, name, name, name, name, workspaceName)
+	}
+
+	return fmt.Sprintf(`
+%s
+
+resource "prefect_work_pool" "%s" {
+	name = "%s"
+	type = "process"
+	concurrency_limit = 1
+	workspace_id = prefect_workspace.%s.id
+}
+
+resource "prefect_flow" "%s" {
+	name = "%s"
+	workspace_id = prefect_workspace.%s.id
+}
+%s`, workspace, name, name, workspaceName, name, name, workspaceName, deployment)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_concurrency_exceeds_pool(t *testing.T) {
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+	randomName := testutils.NewRandomPrefixedString()
+	deploymentResourceName := "prefect_deployment." + randomName
+	workPoolResourceName := "prefect_work_pool." + randomName
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Create the work pool first, so it can be looked up while planning the deployment
+				Config: fixtureAccDeploymentPoolConcurrency(workspace, workspaceName, randomName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(workPoolResourceName, "concurrency_limit", "1"),
+				),
+			},
+			{
+				// A deployment limit above the pool's limit only produces a warning
+				// (which the testing framework can't assert on), so check that
+				// the plan isn't blocked and the limit is applied as configured
+				Config: fixtureAccDeploymentPoolConcurrency(workspace, workspaceName, randomName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(deploymentResourceName, "concurrency_limit", "5"),
+					resource.TestCheckResourceAttrPair(deploymentResourceName, "work_pool_name", workPoolResourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func fixtureAccDeploymentMinimal(workspace, workspaceName, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "prefect_flow" "%s" {
+	name = "%s"
+	workspace_id = prefect_workspace.%s.id
+}
+
+resource "prefect_deployment" "%s" {
+	name = "%s"
+	flow_id = prefect_flow.%s.id
+	workspace_id = prefect_workspace.%s.id
+}
+`, workspace, name, name, workspaceName, name, name, name, workspaceName)
+}
+
+func fixtureAccDeploymentParameterSchemaChecksum(workspace, workspaceName, name, description, parameterSchema string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "prefect_flow" "%s" {
+	name = "%s"
+	workspace_id = prefect_workspace.%s.id
+}
+
+resource "prefect_deployment" "%s" {
+	name = "%s"
+	description = "%s"
+	flow_id = prefect_flow.%s.id
+	workspace_id = prefect_workspace.%s.id
+	parameter_openapi_schema = jsonencode(%s)
+}
+`, workspace, name, name, workspaceName, name, name, description, name, workspaceName, parameterSchema)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_parameter_schema_checksum(t *testing.T) {
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+	workspaceResourceName := "prefect_workspace." + workspaceName
+	randomName := testutils.NewRandomPrefixedString()
+	deploymentResourceName := "prefect_deployment." + randomName
+
+	// The same schema, with the keys in a different order
+	parameterSchema := `{"type":"object","title":"Parameters","properties":{"name":{"type":"string","title":"name"},"count":{"type":"integer","default":1}},"required":["name"]}`
+	reorderedParameterSchema := `{"required":["name"],"properties":{"count":{"default":1,"type":"integer"},"name":{"title":"name","type":"string"}},"title":"Parameters","type":"object"}`
+
+	var reorderedSchemaMap map[string]interface{}
+	_ = json.Unmarshal([]byte(reorderedParameterSchema), &reorderedSchemaMap)
+	expectedChecksum, _ := helpers.JSONChecksum(reorderedSchemaMap)
+
+	var deployment api.Deployment
+	var workspaceID uuid.UUID
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccDeploymentMinimal(workspace, workspaceName, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(deploymentResourceName, workspaceResourceName, &deployment),
+					resource.TestCheckResourceAttrSet(deploymentResourceName, "parameter_schema_checksum"),
+					func(s *terraform.State) error {
+						workspaceID, _ = uuid.Parse(s.RootModule().Resources[workspaceResourceName].Primary.ID)
+
+						return nil
+					},
+				),
+			},
+			{
+				// Set the schema outside of Terraform, as a CLI deploy would, and check
+				// that the checksum matches the one for the reordered schema
+				PreConfig: func() {
+					c, _ := testutils.NewTestClient()
+					deploymentsClient, _ := c.Deployments(uuid.Nil, workspaceID)
+
+					var schemaMap map[string]interface{}
+					_ = json.Unmarshal([]byte(parameterSchema), &schemaMap)
+					err := deploymentsClient.Update(context.Background(), deployment.ID, api.DeploymentUpdate{
+						ParameterOpenAPISchema: &schemaMap,
+					})
+					if err != nil {
+						t.Fatalf("error updating deployment out of band: %s", err)
+					}
+				},
+				Config: fixtureAccDeploymentMinimal(workspace, workspaceName, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(deploymentResourceName, "parameter_schema_checksum", expectedChecksum),
+				),
+			},
+			{
+				// Check that the checksum is stable across refreshes
+				Config: fixtureAccDeploymentMinimal(workspace, workspaceName, randomName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(deploymentResourceName, "parameter_schema_checksum", expectedChecksum),
+				),
+			},
+			{
+				// Check that the checksum stays known when an unrelated attribute changes
+				Config: fixtureAccDeploymentParameterSchemaChecksum(workspace, workspaceName, randomName, "updated", reorderedParameterSchema),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue(deploymentResourceName, tfjsonpath.New("parameter_schema_checksum"), knownvalue.StringExact(expectedChecksum)),
+					},
+				},
+			},
+			{
+				// Check that the checksum is only unknown when the schema changes
+				Config: fixtureAccDeploymentParameterSchemaChecksum(workspace, workspaceName, randomName, "updated", `{"type":"object","properties":{}}`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectUnknownValue(deploymentResourceName, tfjsonpath.New("parameter_schema_checksum")),
+					},
+				},
+			},
+		},
+	})
+}
+
+func fixtureAccDeploymentMergeParameters(workspace, workspaceName, name string, mergeParameters bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "prefect_flow" "%s" {
+	name = "%s"
+	workspace_id = prefect_workspace.%s.id
+}
+
+resource "prefect_deployment" "%s" {
+	name = "%s"
+	flow_id = prefect_flow.%s.id
+	parameters = jsonencode({
+		"some-parameter": "some-value"
+	})
+	merge_parameters = %t
+	workspace_id = prefect_workspace.%s.id
+}
+`, workspace, name, name, workspaceName, name, name, name, mergeParameters, workspaceName)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_external_parameters(t *testing.T) {
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+	workspaceResourceName := "prefect_workspace." + workspaceName
+	randomName := testutils.NewRandomPrefixedString()
+	deploymentResourceName := "prefect_deployment." + randomName
+
+	var deployment api.Deployment
+	var workspaceID uuid.UUID
+
+	// addExternalParameter adds a parameter outside of Terraform, as another tool would.
+	addExternalParameter := func() {
+		c, _ := testutils.NewTestClient()
+		deploymentsClient, _ := c.Deployments(uuid.Nil, workspaceID)
+
+		parameters := map[string]interface{}{
+			"some-parameter":     "some-value",
+			"external-parameter": "external-value",
+		}
+		err := deploymentsClient.Update(context.Background(), deployment.ID, api.DeploymentUpdate{
+			Parameters: &parameters,
+		})
+		if err != nil {
+			t.Fatalf("error updating deployment out of band: %s", err)
+		}
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccDeploymentMergeParameters(workspace, workspaceName, randomName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(deploymentResourceName, workspaceResourceName, &deployment),
+					resource.TestCheckResourceAttr(deploymentResourceName, "parameters", `{"some-parameter":"some-value"}`),
+					resource.TestCheckResourceAttr(deploymentResourceName, "merge_parameters", "false"),
+					func(s *terraform.State) error {
+						workspaceID, _ = uuid.Parse(s.RootModule().Resources[workspaceResourceName].Primary.ID)
+
+						return nil
+					},
+				),
+			},
+			{
+				// Check that in replace mode, the external parameter is planned for removal
+				PreConfig: addExternalParameter,
+				Config:    fixtureAccDeploymentMergeParameters(workspace, workspaceName, randomName, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(deploymentResourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(deploymentResourceName, tfjsonpath.New("parameters"), knownvalue.StringExact(`{"some-parameter":"some-value"}`)),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(deploymentResourceName, "parameters", `{"some-parameter":"some-value"}`),
+				),
+			},
+			{
+				// Check that in merge mode, the configured parameters are planned as is,
+				// and the external parameter is kept
+				PreConfig: addExternalParameter,
+				Config:    fixtureAccDeploymentMergeParameters(workspace, workspaceName, randomName, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue(deploymentResourceName, tfjsonpath.New("parameters"), knownvalue.StringExact(`{"some-parameter":"some-value"}`)),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(deploymentResourceName, "parameters", `{"some-parameter":"some-value"}`),
+					resource.TestCheckResourceAttr(deploymentResourceName, "parameters_all", `{"external-parameter":"external-value","some-parameter":"some-value"}`),
+					resource.TestCheckResourceAttr(deploymentResourceName, "merge_parameters", "true"),
+				),
+			},
+			{
+				// Check that parameters added outside of Terraform in merge mode don't show up as drift
+				PreConfig: addExternalParameter,
+				Config:    fixtureAccDeploymentMergeParameters(workspace, workspaceName, randomName, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func fixtureAccDeploymentParametersObject(workspace, workspaceName, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "prefect_flow" "%s" {
+	name = "%s"
+	workspace_id = prefect_workspace.%s.id
+}
+
+resource "prefect_deployment" "%s" {
+	name = "%s"
+	flow_id = prefect_flow.%s.id
+	parameters_object = {
+		name = "some-name"
+		count = 2
+		nested = {
+			flag = true
+		}
+	}
+	workspace_id = prefect_workspace.%s.id
+}
+`, workspace, name, name, workspaceName, name, name, name, workspaceName)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_parameters_object(t *testing.T) {
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+	workspaceResourceName := "prefect_workspace." + workspaceName
+	randomName := testutils.NewRandomPrefixedString()
+	deploymentResourceName := "prefect_deployment." + randomName
+
+	var deployment api.Deployment
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccDeploymentParametersObject(workspace, workspaceName, randomName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue(deploymentResourceName, tfjsonpath.New("parameters"), knownvalue.StringExact(`{"count":2,"name":"some-name","nested":{"flag":true}}`)),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(deploymentResourceName, workspaceResourceName, &deployment),
+					resource.TestCheckResourceAttr(deploymentResourceName, "parameters", `{"count":2,"name":"some-name","nested":{"flag":true}}`),
+					resource.TestCheckResourceAttr(deploymentResourceName, "parameters_object.name", "some-name"),
+					// Check that the parameters were sent when creating the deployment
+					func(_ *terraform.State) error {
+						if deployment.Parameters["name"] != "some-name" {
+							return fmt.Errorf("expected the deployment to be created with parameters, got: %v", deployment.Parameters)
+						}
+
+						return nil
+					},
+				),
+			},
+			{
+				// Check that the serialized parameters don't show up as drift
+				Config: fixtureAccDeploymentParametersObject(workspace, workspaceName, randomName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func fixtureAccDeploymentDefaultQueue(workspace, workspaceName, name string, withDeployment bool) string {
+	deployment := ""
+	if withDeployment {
+		deployment = fmt.Sprintf(`
+resource "prefect_deployment" "%s" {
+	name = "%s"
+	flow_id = prefect_flow.%s.id
+	work_pool_name = prefect_work_pool.%s.name
+	workspace_id = prefect_workspace.%s.id
+}
+`, name, name, name, name, workspaceName)
+	}
+
+	return fmt.Sprintf(`
+%s
+
+resource "prefect_work_pool" "%s" {
+	name = "%s"
+	type = "process"
+	workspace_id = prefect_workspace.%s.id
+}
+
+resource "prefect_flow" "%s" {
+	name = "%s"
+	workspace_id = prefect_workspace.%s.id
+}
+%s`, workspace, name, name, workspaceName, name, name, workspaceName, deployment)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_default_work_queue(t *testing.T) {
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+	randomName := testutils.NewRandomPrefixedString()
+	deploymentResourceName := "prefect_deployment." + randomName
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Create the work pool first, so its default queue can be resolved while planning the deployment
+				Config: fixtureAccDeploymentDefaultQueue(workspace, workspaceName, randomName, false),
+			},
+			{
+				Config: fixtureAccDeploymentDefaultQueue(workspace, workspaceName, randomName, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(deploymentResourceName, plancheck.ResourceActionCreate),
+						plancheck.ExpectKnownValue(deploymentResourceName, tfjsonpath.New("work_queue_name"), knownvalue.StringExact("default")),
+						plancheck.ExpectKnownValue(deploymentResourceName, tfjsonpath.New("work_queue_id"), knownvalue.NotNull()),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(deploymentResourceName, "work_queue_name", "default"),
+					resource.TestCheckResourceAttrSet(deploymentResourceName, "work_queue_id"),
+				),
+			},
+			{
+				// Check that the resolved default queue doesn't show up as a diff
+				Config: fixtureAccDeploymentDefaultQueue(workspace, workspaceName, randomName, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_no_work_pool_or_queue(t *testing.T) {
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+	randomName := testutils.NewRandomPrefixedString()
+	deploymentResourceName := "prefect_deployment." + randomName
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// A deployment without a work pool or work queue only produces a
+				// warning (which the testing framework can't assert on), so check
+				// that the plan isn't blocked and neither is set
+				Config: fixtureAccDeploymentMinimal(workspace, workspaceName, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(deploymentResourceName, "work_pool_name", ""),
+				),
+			},
+		},
+	})
+}
+
 // testAccCheckDeploymentExists is a Custom Check Function that
 // verifies that the API object was created correctly.
 func testAccCheckDeploymentExists(deploymentResourceName string, workspaceResourceName string, deployment *api.Deployment) resource.TestCheckFunc {
@@ -253,3 +1969,588 @@ func testAccCheckDeploymentValues(fetchedDeployment *api.Deployment, expectedVal
 		return nil
 	}
 }
+
+func fixtureAccDeploymentSkipDestroy(workspace, workspaceName, name string, withDeployment bool) string {
+	deployment := ""
+	if withDeployment {
+		deployment = fmt.Sprintf(`
+resource "prefect_deployment" "%s" {
+	name = "%s"
+	flow_id = prefect_flow.%s.id
+	skip_destroy = true
+	workspace_id = prefect_workspace.%s.id
+}
+`, name, name, name, workspaceName)
+	}
+
+	return fmt.Sprintf(`
+%s
+
+resource "prefect_flow" "%s" {
+	name = "%s"
+	workspace_id = prefect_workspace.%s.id
+}
+%s`, workspace, name, name, workspaceName, deployment)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_skip_destroy(t *testing.T) {
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+	workspaceResourceName := "prefect_workspace." + workspaceName
+	randomName := testutils.NewRandomPrefixedString()
+	deploymentResourceName := "prefect_deployment." + randomName
+
+	var deployment api.Deployment
+	var workspaceID uuid.UUID
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccDeploymentSkipDestroy(workspace, workspaceName, randomName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(deploymentResourceName, workspaceResourceName, &deployment),
+					resource.TestCheckResourceAttr(deploymentResourceName, "skip_destroy", "true"),
+					func(s *terraform.State) error {
+						workspaceID, _ = uuid.Parse(s.RootModule().Resources[workspaceResourceName].Primary.ID)
+
+						return nil
+					},
+				),
+			},
+			{
+				// Remove the deployment from the configuration, and check that
+				// it was only removed from the state, not deleted in Prefect
+				Config: fixtureAccDeploymentSkipDestroy(workspace, workspaceName, randomName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) error {
+						if _, ok := s.RootModule().Resources[deploymentResourceName]; ok {
+							return fmt.Errorf("expected %s to be removed from the state", deploymentResourceName)
+						}
+
+						c, _ := testutils.NewTestClient()
+						deploymentsClient, _ := c.Deployments(uuid.Nil, workspaceID)
+						if _, err := deploymentsClient.Get(context.Background(), deployment.ID); err != nil {
+							return fmt.Errorf("expected deployment %s to still exist: %w", deployment.ID, err)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func fixtureAccDeploymentParameterSchema(workspace, workspaceName, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "prefect_flow" "%s" {
+	name = "%s"
+	workspace_id = prefect_workspace.%s.id
+}
+
+resource "prefect_deployment" "%s" {
+	name = "%s"
+	flow_id = prefect_flow.%s.id
+	parameter_openapi_schema = jsonencode({
+		properties = {
+			name = { type = "string", position = 0 }
+		}
+	})
+	workspace_id = prefect_workspace.%s.id
+}
+`, workspace, name, name, workspaceName, name, name, name, workspaceName)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_parameter_openapi_schema(t *testing.T) {
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+	workspaceResourceName := "prefect_workspace." + workspaceName
+	randomName := testutils.NewRandomPrefixedString()
+	deploymentResourceName := "prefect_deployment." + randomName
+
+	var deployment api.Deployment
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccDeploymentParameterSchema(workspace, workspaceName, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(deploymentResourceName, workspaceResourceName, &deployment),
+					resource.TestCheckResourceAttr(deploymentResourceName, "parameter_openapi_schema", `{"properties":{"name":{"position":0,"type":"string"}}}`),
+				),
+			},
+			{
+				// Check that the schema doesn't show up as a diff, even if the
+				// API fills in defaults such as its title and type
+				Config: fixtureAccDeploymentParameterSchema(workspace, workspaceName, randomName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func fixtureAccDeploymentBlockDocuments(workspace, workspaceName, name string, withDocuments bool) string {
+	documents := ""
+	if withDocuments {
+		documents = `
+	storage_document_id = data.prefect_block.storage.id
+	infrastructure_document_id = prefect_block.infrastructure.id`
+	}
+
+	return fmt.Sprintf(`
+%s
+
+resource "prefect_flow" "%s" {
+	name = "%s"
+	workspace_id = prefect_workspace.%s.id
+}
+
+resource "prefect_block" "storage" {
+	name = "%s-storage"
+	type_slug = "local-file-system"
+	data = jsonencode({ basepath = "/tmp" })
+	workspace_id = prefect_workspace.%s.id
+}
+
+resource "prefect_block" "infrastructure" {
+	name = "%s-infrastructure"
+	type_slug = "json"
+	data = jsonencode({ value = { type = "process" } })
+	workspace_id = prefect_workspace.%s.id
+}
+
+data "prefect_block" "storage" {
+	name = prefect_block.storage.name
+	type_slug = "local-file-system"
+	workspace_id = prefect_workspace.%s.id
+}
+
+resource "prefect_deployment" "%s" {
+	name = "%s"
+	flow_id = prefect_flow.%s.id%s
+	workspace_id = prefect_workspace.%s.id
+}
+`, workspace, name, name, workspaceName, name, workspaceName, name, workspaceName, workspaceName, name, name, name, documents, workspaceName)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_block_documents(t *testing.T) {
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+	workspaceResourceName := "prefect_workspace." + workspaceName
+	randomName := testutils.NewRandomPrefixedString()
+	deploymentResourceName := "prefect_deployment." + randomName
+
+	var deployment api.Deployment
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check that block documents can be referenced by ID, including
+				// one looked up by name with the prefect_block data source
+				Config: fixtureAccDeploymentBlockDocuments(workspace, workspaceName, randomName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(deploymentResourceName, workspaceResourceName, &deployment),
+					resource.TestCheckResourceAttrPair(deploymentResourceName, "storage_document_id", "prefect_block.storage", "id"),
+					resource.TestCheckResourceAttrPair(deploymentResourceName, "infrastructure_document_id", "prefect_block.infrastructure", "id"),
+					func(_ *terraform.State) error {
+						if deployment.StorageDocumentID == nil || deployment.InfrastructureDocumentID == nil {
+							return fmt.Errorf("expected the deployment to reference its block documents")
+						}
+
+						return nil
+					},
+				),
+			},
+			{
+				// Check that unsetting the IDs clears the associations server-side
+				Config: fixtureAccDeploymentBlockDocuments(workspace, workspaceName, randomName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(deploymentResourceName, workspaceResourceName, &deployment),
+					resource.TestCheckNoResourceAttr(deploymentResourceName, "storage_document_id"),
+					resource.TestCheckNoResourceAttr(deploymentResourceName, "infrastructure_document_id"),
+					func(_ *terraform.State) error {
+						if deployment.StorageDocumentID != nil || deployment.InfrastructureDocumentID != nil {
+							return fmt.Errorf("expected the deployment's block documents to be cleared, got %v and %v", deployment.StorageDocumentID, deployment.InfrastructureDocumentID)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func fixtureAccDeploymentUpdatedBy(endpoint, name string) string {
+	return fmt.Sprintf(`
+provider "prefect" {
+	endpoint = "%s"
+}
+
+resource "prefect_deployment" "%s" {
+	name = "%s"
+	flow_id = "00000000-0000-0000-0000-000000000000"
+}
+`, endpoint, name, name)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_updated_by(t *testing.T) {
+	randomName := testutils.NewRandomPrefixedString()
+	deploymentResourceName := "prefect_deployment." + randomName
+
+	// The server reports the service account that applied the configuration,
+	// until a user edits the deployment outside of Terraform.
+	updatedBy := `{"id": "7a2f2a1d-9b6e-4e0d-8e1a-6b7c8d9e0f1a", "type": "BOT", "display_value": "terraform-ci"}`
+	var mutex sync.Mutex
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		deployment := fmt.Sprintf(`{
+			"id": "8b3a3b2e-0c7f-4f1e-9f2b-7c8d9e0f1a2b",
+			"name": %q,
+			"flow_id": "00000000-0000-0000-0000-000000000000",
+			"paused": false,
+			"tags": [],
+			"pull_steps": [],
+			"updated_by": %s
+		}`, randomName, updatedBy)
+		mutex.Unlock()
+
+		switch {
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/deployments/"):
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(deployment))
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/deployments/8b3a3b2e-0c7f-4f1e-9f2b-7c8d9e0f1a2b"):
+			_, _ = w.Write([]byte(deployment))
+		case r.Method == http.MethodDelete && strings.HasSuffix(r.URL.Path, "/deployments/8b3a3b2e-0c7f-4f1e-9f2b-7c8d9e0f1a2b"):
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == "/api/admin/version":
+			_, _ = w.Write([]byte(`"3.1.0"`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccDeploymentUpdatedBy(server.URL, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(deploymentResourceName, "updated_by.id", "7a2f2a1d-9b6e-4e0d-8e1a-6b7c8d9e0f1a"),
+					resource.TestCheckResourceAttr(deploymentResourceName, "updated_by.type", "BOT"),
+					resource.TestCheckResourceAttr(deploymentResourceName, "updated_by.display_value", "terraform-ci"),
+				),
+			},
+			{
+				// Check that an edit made outside of Terraform shows up on refresh
+				PreConfig: func() {
+					mutex.Lock()
+					defer mutex.Unlock()
+					updatedBy = `{"id": "9c4b4c3f-1d8a-4a2f-8a3c-8d9e0f1a2b3c", "type": "USER", "display_value": "jane.doe"}`
+				},
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(deploymentResourceName, "updated_by.id", "9c4b4c3f-1d8a-4a2f-8a3c-8d9e0f1a2b3c"),
+					resource.TestCheckResourceAttr(deploymentResourceName, "updated_by.type", "USER"),
+					resource.TestCheckResourceAttr(deploymentResourceName, "updated_by.display_value", "jane.doe"),
+				),
+			},
+		},
+	})
+}
+
+func fixtureAccDeploymentPausedMock(endpoint, name string, paused bool) string {
+	return fmt.Sprintf(`
+provider "prefect" {
+	endpoint = "%s"
+}
+
+resource "prefect_deployment" "%s" {
+	name = "%s"
+	flow_id = "00000000-0000-0000-0000-000000000000"
+	paused = %t
+}
+`, endpoint, name, name, paused)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_pause_endpoints(t *testing.T) {
+	randomName := testutils.NewRandomPrefixedString()
+	deploymentResourceName := "prefect_deployment." + randomName
+	deploymentPath := "/deployments/9d5c5d4a-2e9b-4b3a-9b4d-9e0f1a2b3c4d"
+
+	// The server keeps track of the paused flag, and of whether it was
+	// ever changed through a regular update.
+	paused := false
+	pausedPatched := false
+	var mutex sync.Mutex
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		switch {
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/deployments/"):
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodPatch && strings.HasSuffix(r.URL.Path, deploymentPath):
+			var payload map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&payload)
+			if _, ok := payload["paused"]; ok {
+				pausedPatched = true
+			}
+			w.WriteHeader(http.StatusNoContent)
+
+			return
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, deploymentPath+"/pause_deployment"):
+			paused = true
+			w.WriteHeader(http.StatusOK)
+
+			return
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, deploymentPath+"/resume_deployment"):
+			paused = false
+			w.WriteHeader(http.StatusOK)
+
+			return
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, deploymentPath):
+		case r.Method == http.MethodDelete && strings.HasSuffix(r.URL.Path, deploymentPath):
+			w.WriteHeader(http.StatusNoContent)
+
+			return
+		default:
+			http.NotFound(w, r)
+
+			return
+		}
+
+		_, _ = fmt.Fprintf(w, `{
+			"id": "9d5c5d4a-2e9b-4b3a-9b4d-9e0f1a2b3c4d",
+			"name": %q,
+			"flow_id": "00000000-0000-0000-0000-000000000000",
+			"paused": %t,
+			"tags": [],
+			"pull_steps": []
+		}`, randomName, paused)
+	}))
+	defer server.Close()
+
+	checkNotPatched := func(_ *terraform.State) error {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if pausedPatched {
+			return fmt.Errorf("expected paused to be changed through the pause and resume endpoints, not a regular update")
+		}
+
+		return nil
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccDeploymentPausedMock(server.URL, randomName, false),
+				Check:  resource.TestCheckResourceAttr(deploymentResourceName, "paused", "false"),
+			},
+			{
+				// Check that pausing uses the pause endpoint
+				Config: fixtureAccDeploymentPausedMock(server.URL, randomName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(deploymentResourceName, "paused", "true"),
+					checkNotPatched,
+				),
+			},
+			{
+				// Check that resuming uses the resume endpoint
+				Config: fixtureAccDeploymentPausedMock(server.URL, randomName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(deploymentResourceName, "paused", "false"),
+					checkNotPatched,
+				),
+			},
+		},
+	})
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_error_context(t *testing.T) {
+	randomName := testutils.NewRandomPrefixedString()
+
+	// The server fails to create the deployment, as an unavailable API would.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/deployments/") {
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check that the error names the deployment and its flow, not just the operation
+				Config:      fixtureAccDeploymentPausedMock(server.URL, randomName, false),
+				ExpectError: regexp.MustCompile(`Deployment\s+"` + randomName + `"\s+\(flow_id\s+00000000-0000-0000-0000-000000000000\):\s+Could\s+not\s+create\s+deployment`),
+			},
+		},
+	})
+}
+
+func fixtureAccDeploymentSecretParametersMock(endpoint, name, parameters string) string {
+	return fmt.Sprintf(`
+provider "prefect" {
+	endpoint = "%s"
+}
+
+resource "prefect_deployment" "%s" {
+	name = "%s"
+	flow_id = "00000000-0000-0000-0000-000000000000"
+	parameters = jsonencode(%s)
+}
+`, endpoint, name, name, parameters)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_secret_parameters(t *testing.T) {
+	randomName := testutils.NewRandomPrefixedString()
+	deploymentResourceName := "prefect_deployment." + randomName
+	deploymentPath := "/deployments/9d5c5d4a-2e9b-4b3a-9b4d-9e0f1a2b3c4d"
+
+	// The parameter schema marks `token` as secret, the way Pydantic
+	// describes a SecretStr, and the token was set outside of Terraform,
+	// e.g. by `prefect deploy`.
+	parameters := map[string]interface{}{"token": "s3cr3t"}
+	var mutex sync.Mutex
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		switch {
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/deployments/"):
+			var payload api.DeploymentCreate
+			_ = json.NewDecoder(r.Body).Decode(&payload)
+			for name, value := range payload.Parameters {
+				parameters[name] = value
+			}
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodPatch && strings.HasSuffix(r.URL.Path, deploymentPath):
+			var payload api.DeploymentUpdate
+			_ = json.NewDecoder(r.Body).Decode(&payload)
+			if payload.Parameters != nil {
+				parameters = *payload.Parameters
+			}
+			w.WriteHeader(http.StatusNoContent)
+
+			return
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, deploymentPath):
+		case r.Method == http.MethodDelete && strings.HasSuffix(r.URL.Path, deploymentPath):
+			w.WriteHeader(http.StatusNoContent)
+
+			return
+		default:
+			http.NotFound(w, r)
+
+			return
+		}
+
+		encodedParameters, _ := json.Marshal(parameters)
+		_, _ = fmt.Fprintf(w, `{
+			"id": "9d5c5d4a-2e9b-4b3a-9b4d-9e0f1a2b3c4d",
+			"name": %q,
+			"flow_id": "00000000-0000-0000-0000-000000000000",
+			"tags": [],
+			"pull_steps": [],
+			"parameters": %s,
+			"parameter_openapi_schema": {
+				"type": "object",
+				"properties": {
+					"name": {"type": "string"},
+					"token": {"type": "string", "format": "password", "writeOnly": true}
+				}
+			}
+		}`, randomName, encodedParameters)
+	}))
+	defer server.Close()
+
+	checkServerToken := func(_ *terraform.State) error {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if parameters["token"] != "s3cr3t" {
+			return fmt.Errorf("expected the token parameter to be kept on the server, got: %v", parameters["token"])
+		}
+
+		return nil
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Check that the secret parameter is kept out of `parameters`
+				Config: fixtureAccDeploymentSecretParametersMock(server.URL, randomName, `{ name = "x" }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(deploymentResourceName, "parameters", `{"name":"x"}`),
+					resource.TestCheckResourceAttr(deploymentResourceName, "sensitive_parameters", `{"token":"s3cr3t"}`),
+				),
+			},
+			{
+				// Check that updating the parameters keeps the secret one
+				Config: fixtureAccDeploymentSecretParametersMock(server.URL, randomName, `{ name = "y" }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(deploymentResourceName, "parameters", `{"name":"y"}`),
+					resource.TestCheckResourceAttr(deploymentResourceName, "sensitive_parameters", `{"token":"s3cr3t"}`),
+					checkServerToken,
+				),
+			},
+		},
+	})
+}
+
+func TestSecretParametersHelper(t *testing.T) {
+	t.Parallel()
+
+	var parameterSchema map[string]interface{}
+	err := json.Unmarshal([]byte(`{
+		"type": "object",
+		"properties": {
+			"name": {"type": "string"},
+			"token": {"type": "string", "format": "password", "writeOnly": true},
+			"password": {"anyOf": [{"type": "string", "format": "password", "writeOnly": true}, {"type": "null"}]},
+			"credentials": {"$ref": "#/definitions/Secret"},
+			"loop": {"$ref": "#/definitions/Loop"}
+		},
+		"definitions": {
+			"Secret": {"type": "string", "writeOnly": true},
+			"Loop": {"anyOf": [{"$ref": "#/definitions/Loop"}]}
+		}
+	}`), &parameterSchema)
+	if err != nil {
+		t.Fatalf("error decoding parameter schema: %s", err)
+	}
+
+	want := []string{"credentials", "password", "token"}
+	if got := helpers.SecretParameters(parameterSchema); !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected secret parameters %v, got %v", want, got)
+	}
+}
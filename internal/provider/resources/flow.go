@@ -2,6 +2,7 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -12,7 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -20,16 +21,19 @@ import (
 
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 )
 
 var (
 	_ = resource.ResourceWithConfigure(&FlowResource{})
 	_ = resource.ResourceWithImportState(&FlowResource{})
+	_ = resource.ResourceWithModifyPlan(&FlowResource{})
 )
 
 // FlowResource contains state for the resource.
 type FlowResource struct {
-	client api.PrefectClient
+	client   api.PrefectClient
+	settings helpers.ProviderSettings
 }
 
 // FlowResourceModel defines the Terraform resource model.
@@ -40,8 +44,10 @@ type FlowResourceModel struct {
 	WorkspaceID customtypes.UUIDValue      `tfsdk:"workspace_id"`
 	AccountID   customtypes.UUIDValue      `tfsdk:"account_id"`
 
-	Name types.String `tfsdk:"name"`
-	Tags types.List   `tfsdk:"tags"`
+	Name    types.String `tfsdk:"name"`
+	Tags    types.List   `tfsdk:"tags"`
+	TagsAll types.List   `tfsdk:"tags_all"`
+	Labels  types.Map    `tfsdk:"labels"`
 }
 
 // NewFlowResource returns a new FlowResource.
@@ -62,22 +68,24 @@ func (r *FlowResource) Configure(_ context.Context, req resource.ConfigureReques
 		return
 	}
 
-	client, ok := req.ProviderData.(api.PrefectClient)
+	data, ok := req.ProviderData.(*helpers.ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected provider client type",
-			fmt.Sprintf("Expected api.PrefectClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *helpers.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.settings = data.Settings
 }
 
 // Schema defines the schema for the resource.
 func (r *FlowResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	defaultEmptyTagList, _ := basetypes.NewListValue(types.StringType, []attr.Value{})
+	defaultEmptyLabelMap, _ := basetypes.NewMapValue(types.StringType, map[string]attr.Value{})
 
 	resp.Schema = schema.Schema{
 		Description: "The resource `flow` represents a Prefect Cloud Flow. " +
@@ -117,40 +125,82 @@ func (r *FlowResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Description: "Workspace ID (UUID)",
 			},
 			"name": schema.StringAttribute{
-				Description: "Name of the flow",
+				Description: "Name of the flow. Flow names are unique per workspace, and changing the name replaces the flow.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 
 			"tags": schema.ListAttribute{
-				Description: "Tags associated with the flow",
+				Description: "Tags associated with the flow. The provider's `default_tags` are merged into `tags_all`.",
 				ElementType: types.StringType,
 				Optional:    true,
 				Computed:    true,
 				Default:     listdefault.StaticValue(defaultEmptyTagList),
-				PlanModifiers: []planmodifier.List{
-					listplanmodifier.RequiresReplace(),
-				},
+			},
+			"tags_all": schema.ListAttribute{
+				Description: "All tags of the flow, i.e. `tags` with the provider's `default_tags` merged in.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"labels": schema.MapAttribute{
+				Description: "Key/value labels associated with the flow, e.g. for ownership or cost metadata",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     mapdefault.StaticValue(defaultEmptyLabelMap),
 			},
 		},
 	}
 }
 
 // copyFlowToModel copies an api.Flow to a FlowResourceModel.
-func copyFlowToModel(ctx context.Context, flow *api.Flow, model *FlowResourceModel) diag.Diagnostics {
+func copyFlowToModel(ctx context.Context, flow *api.Flow, model *FlowResourceModel, defaultTags map[string]string) diag.Diagnostics {
 	model.ID = types.StringValue(flow.ID.String())
 	model.Created = customtypes.NewTimestampPointerValue(flow.Created)
 	model.Updated = customtypes.NewTimestampPointerValue(flow.Updated)
 	model.Name = types.StringValue(flow.Name)
 
-	tags, diags := types.ListValueFrom(ctx, types.StringType, flow.Tags)
+	tags, tagsAll, diags := tagsFromAPI(ctx, flow.Tags, model.Tags, defaultTags)
 	if diags.HasError() {
 		return diags
 	}
 	model.Tags = tags
+	model.TagsAll = tagsAll
+
+	// Older servers don't return labels at all, so we'll
+	// store an empty map to match the schema default.
+	flowLabels := flow.Labels
+	if flowLabels == nil {
+		flowLabels = map[string]string{}
+	}
+
+	labels, diags := types.MapValueFrom(ctx, types.StringType, flowLabels)
+	if diags.HasError() {
+		return diags
+	}
+	model.Labels = labels
 
 	return nil
 }
 
+// ModifyPlan merges the provider's default tags into the planned tags_all.
+func (r *FlowResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to reconcile on destroy.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan FlowResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planTagsAll(ctx, plan.Tags, r.settings.DefaultTags, resp)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *FlowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan FlowResourceModel
@@ -167,6 +217,12 @@ func (r *FlowResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	var labels map[string]string
+	resp.Diagnostics.Append(plan.Labels.ElementsAs(ctx, &labels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	client, err := r.client.Flows(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -175,10 +231,25 @@ func (r *FlowResource) Create(ctx context.Context, req resource.CreateRequest, r
 		)
 	}
 
+	// The model is populated from the configuration, so the
+	// provider's default tags haven't been merged in yet.
+	tags = helpers.MergeDefaultTags(tags, r.settings.DefaultTags)
+
 	flow, err := client.Create(ctx, api.FlowCreate{
-		Name: plan.Name.ValueString(),
-		Tags: tags,
+		Name:   plan.Name.ValueString(),
+		Tags:   tags,
+		Labels: labels,
 	})
+	if errors.Is(err, api.ErrFlowAlreadyExists) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Flow already exists",
+			fmt.Sprintf("Flow names are unique per workspace, and %s. "+
+				"Potential resolutions: choose a different name, or import the existing flow with `terraform import`.", err),
+		)
+
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating flow",
@@ -188,7 +259,7 @@ func (r *FlowResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	resp.Diagnostics.Append(copyFlowToModel(ctx, flow, &plan)...)
+	resp.Diagnostics.Append(copyFlowToModel(ctx, flow, &plan, r.settings.DefaultTags)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -217,10 +288,13 @@ func (r *FlowResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		)
 	}
 
-	// A flow can be imported + read by specifying the workspace_id and the flow_id.
+	// A flow can be imported + read by specifying the workspace_id and either
+	// the flow_id or the flow name.
 	// if the workspace_id is omitted, then the default workspace_id is used.
 	var flow *api.Flow
-	if !model.ID.IsNull() {
+	if model.ID.IsNull() {
+		flow, err = client.GetByName(ctx, model.Name.ValueString())
+	} else {
 		var flowID uuid.UUID
 		flowID, err = uuid.Parse(model.ID.ValueString())
 		if err != nil {
@@ -245,7 +319,7 @@ func (r *FlowResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	resp.Diagnostics.Append(copyFlowToModel(ctx, flow, &model)...)
+	resp.Diagnostics.Append(copyFlowToModel(ctx, flow, &model, r.settings.DefaultTags)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -257,8 +331,89 @@ func (r *FlowResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
-func (r *FlowResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
-	// Unsupported - redeploy by default
+// Only the tags and labels can be updated in place; the name requires replacement.
+func (r *FlowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state FlowResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.Flows(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating flows client",
+			fmt.Sprintf("Could not create flows client, unexpected error: %s. This is a bug in the provider, please report this to the maintainers.", err.Error()),
+		)
+
+		return
+	}
+
+	flowID, err := uuid.Parse(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Error parsing Flow ID",
+			fmt.Sprintf("Could not parse flow ID to UUID, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	// Tags and labels are only sent when they change, so that flows without
+	// labels can still be updated on servers that don't support them.
+	var payload api.FlowUpdate
+
+	if !plan.TagsAll.Equal(state.TagsAll) {
+		tags := []string{}
+		resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &tags, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		tags = helpers.MergeDefaultTags(tags, r.settings.DefaultTags)
+		payload.Tags = &tags
+	}
+
+	if !plan.Labels.Equal(state.Labels) {
+		labels := map[string]string{}
+		resp.Diagnostics.Append(plan.Labels.ElementsAs(ctx, &labels, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		payload.Labels = &labels
+	}
+
+	err = client.Update(ctx, flowID, payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating flow",
+			fmt.Sprintf("Could not update flow, unexpected error: %s", err),
+		)
+
+		return
+	}
+
+	flow, err := client.Get(ctx, flowID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error refreshing flow state",
+			fmt.Sprintf("Could not read Flow, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(copyFlowToModel(ctx, flow, &plan, r.settings.DefaultTags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -310,6 +465,8 @@ func (r *FlowResource) ImportState(ctx context.Context, req resource.ImportState
 	// we'll allow input values in the form of:
 	// - "id,workspace_id"
 	// - "id"
+	// - "name,workspace_id"
+	// - "name"
 	maxInputCount := 2
 	inputParts := strings.Split(req.ID, ",")
 
@@ -333,8 +490,14 @@ func (r *FlowResource) ImportState(ctx context.Context, req resource.ImportState
 		return
 	}
 
+	// The identifier is either the flow ID, or the flow name,
+	// which is unique per workspace.
 	identifier := inputParts[0]
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identifier)...)
+	if _, err := uuid.Parse(identifier); err == nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identifier)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), identifier)...)
+	}
 
 	if len(inputParts) == 2 && inputParts[1] != "" {
 		workspaceID, err := uuid.Parse(inputParts[1])
@@ -0,0 +1,443 @@
+package resources
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var (
+	_ = resource.ResourceWithConfigure(&FlowRunNotificationPolicyResource{})
+	_ = resource.ResourceWithImportState(&FlowRunNotificationPolicyResource{})
+)
+
+// FlowRunNotificationPolicyResource contains state for the resource.
+type FlowRunNotificationPolicyResource struct {
+	client api.PrefectClient
+}
+
+// FlowRunNotificationPolicyResourceModel defines the Terraform resource model.
+type FlowRunNotificationPolicyResourceModel struct {
+	ID          types.String               `tfsdk:"id"`
+	Created     customtypes.TimestampValue `tfsdk:"created"`
+	Updated     customtypes.TimestampValue `tfsdk:"updated"`
+	AccountID   customtypes.UUIDValue      `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue      `tfsdk:"workspace_id"`
+
+	StateNames      types.List            `tfsdk:"state_names"`
+	Tags            types.List            `tfsdk:"tags"`
+	BlockDocumentID customtypes.UUIDValue `tfsdk:"block_document_id"`
+	MessageTemplate types.String          `tfsdk:"message_template"`
+	IsActive        types.Bool            `tfsdk:"is_active"`
+}
+
+// NewFlowRunNotificationPolicyResource returns a new FlowRunNotificationPolicyResource.
+//
+//nolint:ireturn // required by Terraform API
+func NewFlowRunNotificationPolicyResource() resource.Resource {
+	return &FlowRunNotificationPolicyResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *FlowRunNotificationPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_flow_run_notification_policy"
+}
+
+// Configure initializes runtime state for the resource.
+func (r *FlowRunNotificationPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*helpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("resource", req.ProviderData))
+
+		return
+	}
+
+	r.client = data.Client
+}
+
+// Schema defines the schema for the resource.
+func (r *FlowRunNotificationPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	defaultEmptyTagList, _ := basetypes.NewListValue(types.StringType, []attr.Value{})
+
+	resp.Schema = schema.Schema{
+		Description: "The resource `flow_run_notification_policy` represents a Prefect Flow Run Notification Policy. " +
+			"Flow run notification policies send a message through a notifier Block whenever a flow run enters one of the given states.",
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				// We cannot use a CustomType due to a conflict with PlanModifiers; see
+				// https://github.com/hashicorp/terraform-plugin-framework/issues/763
+				// https://github.com/hashicorp/terraform-plugin-framework/issues/754
+				Description: "Flow run notification policy ID (UUID)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was created (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was updated (RFC3339)",
+			},
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"state_names": schema.ListAttribute{
+				Description: "Names of the flow run states that trigger a notification, e.g. `Failed` or `Crashed`",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"tags": schema.ListAttribute{
+				Description: "Only notify for flow runs that have all of these tags. Defaults to notifying for every flow run.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     listdefault.StaticValue(defaultEmptyTagList),
+			},
+			"block_document_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "ID (UUID) of the notifier Block, e.g. a Slack webhook Block, used to send the notification",
+				Required:    true,
+			},
+			"message_template": schema.StringAttribute{
+				Description: "Template of the notification message, e.g. `Flow run {flow_run_name} entered state {flow_run_state_name}`. Defaults to the server's default message.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"is_active": schema.BoolAttribute{
+				Description: "Whether the policy sends notifications",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+// reconcileStringList returns the current list if it holds the same values as
+// the remote list, regardless of order, so that the order in the configuration
+// is kept. Otherwise, the remote values are returned.
+func reconcileStringList(ctx context.Context, current types.List, remote []string) (types.List, diag.Diagnostics) {
+	if !current.IsNull() && !current.IsUnknown() {
+		var values []string
+		diags := current.ElementsAs(ctx, &values, false)
+		if diags.HasError() {
+			return current, diags
+		}
+
+		sortedValues := slices.Clone(values)
+		sortedRemote := slices.Clone(remote)
+		slices.Sort(sortedValues)
+		slices.Sort(sortedRemote)
+		if slices.Equal(sortedValues, sortedRemote) {
+			return current, nil
+		}
+	}
+
+	if remote == nil {
+		remote = []string{}
+	}
+
+	return types.ListValueFrom(ctx, types.StringType, remote)
+}
+
+// copyFlowRunNotificationPolicyToModel maps an API response to a model that is saved in Terraform state.
+func copyFlowRunNotificationPolicyToModel(ctx context.Context, policy *api.FlowRunNotificationPolicy, tfModel *FlowRunNotificationPolicyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tfModel.ID = types.StringValue(policy.ID.String())
+	tfModel.Created = customtypes.NewTimestampPointerValue(policy.Created)
+	tfModel.Updated = customtypes.NewTimestampPointerValue(policy.Updated)
+
+	stateNames, stateNamesDiags := reconcileStringList(ctx, tfModel.StateNames, policy.StateNames)
+	diags.Append(stateNamesDiags...)
+	tfModel.StateNames = stateNames
+
+	tags, tagsDiags := reconcileStringList(ctx, tfModel.Tags, policy.Tags)
+	diags.Append(tagsDiags...)
+	tfModel.Tags = tags
+
+	tfModel.BlockDocumentID = customtypes.NewUUIDValue(policy.BlockDocumentID)
+	// The server treats an empty template as the default message.
+	if policy.MessageTemplate != nil && *policy.MessageTemplate != "" {
+		tfModel.MessageTemplate = types.StringValue(*policy.MessageTemplate)
+	} else {
+		tfModel.MessageTemplate = types.StringNull()
+	}
+	tfModel.IsActive = types.BoolValue(policy.IsActive)
+
+	return diags
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *FlowRunNotificationPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan FlowRunNotificationPolicyResourceModel
+
+	// Populate the model from resource configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateNames := []string{}
+	resp.Diagnostics.Append(plan.StateNames.ElementsAs(ctx, &stateNames, false)...)
+	tags := []string{}
+	resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &tags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.FlowRunNotificationPolicies(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Flow Run Notification Policy", err))
+
+		return
+	}
+
+	policy, err := client.Create(ctx, api.FlowRunNotificationPolicyCreate{
+		IsActive:        plan.IsActive.ValueBool(),
+		StateNames:      stateNames,
+		Tags:            tags,
+		BlockDocumentID: plan.BlockDocumentID.ValueUUID(),
+		MessageTemplate: plan.MessageTemplate.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Flow Run Notification Policy", "create", err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(copyFlowRunNotificationPolicyToModel(ctx, policy, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *FlowRunNotificationPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FlowRunNotificationPolicyResourceModel
+
+	// Populate the model from state and emit diagnostics on error
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.FlowRunNotificationPolicies(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Flow Run Notification Policy", err))
+
+		return
+	}
+
+	policyID, err := uuid.Parse(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ParseUUIDErrorDiagnostic("Flow Run Notification Policy", err))
+
+		return
+	}
+
+	policy, err := client.Get(ctx, policyID)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Flow Run Notification Policy", "get", err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(copyFlowRunNotificationPolicyToModel(ctx, policy, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// Only the attributes that changed are sent to the API.
+func (r *FlowRunNotificationPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state FlowRunNotificationPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.FlowRunNotificationPolicies(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Flow Run Notification Policy", err))
+
+		return
+	}
+
+	policyID, err := uuid.Parse(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ParseUUIDErrorDiagnostic("Flow Run Notification Policy", err))
+
+		return
+	}
+
+	payload := api.FlowRunNotificationPolicyUpdate{}
+	if !plan.IsActive.Equal(state.IsActive) {
+		payload.IsActive = plan.IsActive.ValueBoolPointer()
+	}
+	if !plan.StateNames.Equal(state.StateNames) {
+		stateNames := []string{}
+		resp.Diagnostics.Append(plan.StateNames.ElementsAs(ctx, &stateNames, false)...)
+		payload.StateNames = &stateNames
+	}
+	if !plan.Tags.Equal(state.Tags) {
+		tags := []string{}
+		resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &tags, false)...)
+		payload.Tags = &tags
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !plan.BlockDocumentID.Equal(state.BlockDocumentID) {
+		blockDocumentID := plan.BlockDocumentID.ValueUUID()
+		payload.BlockDocumentID = &blockDocumentID
+	}
+	if !plan.MessageTemplate.Equal(state.MessageTemplate) {
+		// An empty template restores the server's default message when
+		// the attribute is removed from the configuration.
+		messageTemplate := plan.MessageTemplate.ValueString()
+		payload.MessageTemplate = &messageTemplate
+	}
+
+	err = client.Update(ctx, policyID, payload)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Flow Run Notification Policy", "update", err))
+
+		return
+	}
+
+	policy, err := client.Get(ctx, policyID)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Flow Run Notification Policy", "get", err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(copyFlowRunNotificationPolicyToModel(ctx, policy, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *FlowRunNotificationPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state FlowRunNotificationPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.FlowRunNotificationPolicies(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Flow Run Notification Policy", err))
+
+		return
+	}
+
+	policyID, err := uuid.Parse(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ParseUUIDErrorDiagnostic("Flow Run Notification Policy", err))
+
+		return
+	}
+
+	err = client.Delete(ctx, policyID)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Flow Run Notification Policy", "delete", err))
+
+		return
+	}
+}
+
+// ImportState imports the resource into Terraform state.
+// Valid import IDs:
+// <flow_run_notification_policy_id>
+// <flow_run_notification_policy_id>,<workspace_id>.
+func (r *FlowRunNotificationPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ",")
+
+	if len(parts) > 2 || len(parts) == 0 {
+		resp.Diagnostics.AddError(
+			"Error importing flow run notification policy",
+			"Import ID must be in the format of <flow_run_notification_policy_id> OR <flow_run_notification_policy_id>,<workspace_id>",
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[0])...)
+
+	if len(parts) == 2 && parts[1] != "" {
+		workspaceID, err := uuid.Parse(parts[1])
+		if err != nil {
+			resp.Diagnostics.Append(helpers.ParseUUIDErrorDiagnostic("Workspace", err))
+
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), workspaceID.String())...)
+	}
+}
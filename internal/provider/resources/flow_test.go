@@ -2,10 +2,13 @@ package resources_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
 )
@@ -25,6 +28,33 @@ resource "prefect_flow" "flow" {
 `, name, name, name, tag)
 }
 
+func fixtureAccFlowWithLabels(name string, labels map[string]string) string {
+	tmpl := `
+resource "prefect_workspace" "workspace" {
+	handle = "{{.Name}}"
+	name = "{{.Name}}"
+}
+
+resource "prefect_flow" "flow" {
+	name = "{{.Name}}"
+	workspace_id = prefect_workspace.workspace.id
+	labels = {
+	{{- range $key, $value := .Labels}}
+		"{{$key}}" = "{{$value}}"
+	{{- end}}
+	}
+}
+`
+
+	return helpers.RenderTemplate(tmpl, struct {
+		Name   string
+		Labels map[string]string
+	}{
+		Name:   name,
+		Labels: labels,
+	})
+}
+
 //nolint:paralleltest // we use the resource.ParallelTest helper instead
 func TestAccResource_flow(t *testing.T) {
 	resourceName := "prefect_flow.flow"
@@ -45,8 +75,13 @@ func TestAccResource_flow(t *testing.T) {
 				),
 			},
 			{
-				// Check updating the resource
+				// Check updating the resource's tags in place
 				Config: fixtureAccFlowCreate(randomName, "test2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "name", randomName),
 					resource.TestCheckResourceAttr(resourceName, "tags.#", "1"),
@@ -60,6 +95,182 @@ func TestAccResource_flow(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportStateVerify: true,
 			},
+			// Import State checks - import by name
+			{
+				ImportState:       true,
+				ImportStateIdFunc: getFlowImportStateIDByName(randomName, workspaceResourceName),
+				ResourceName:      resourceName,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func fixtureAccFlowDuplicateName(name string) string {
+	return fmt.Sprintf(`
+resource "prefect_workspace" "workspace" {
+	handle = "%s"
+	name = "%s"
+}
+
+resource "prefect_flow" "flow" {
+	name = "%s"
+	workspace_id = prefect_workspace.workspace.id
+}
+
+resource "prefect_flow" "duplicate" {
+	name = "%s"
+	workspace_id = prefect_workspace.workspace.id
+	depends_on = [prefect_flow.flow]
+}
+`, name, name, name, name)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_flow_duplicate_name(t *testing.T) {
+	randomName := testutils.NewRandomPrefixedString()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check that reusing a flow name in the same workspace is surfaced
+				Config:      fixtureAccFlowDuplicateName(randomName),
+				ExpectError: regexp.MustCompile("Flow already exists"),
+			},
+		},
+	})
+}
+
+// getFlowImportStateIDByName generates an import ID of the form
+// `name,workspace_id`, to import a flow by name.
+func getFlowImportStateIDByName(name string, workspaceResourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		workspace, exists := state.RootModule().Resources[workspaceResourceName]
+		if !exists {
+			return "", fmt.Errorf("resource not found in state: %s", workspaceResourceName)
+		}
+
+		return fmt.Sprintf("%s,%s", name, workspace.Primary.ID), nil
+	}
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_flow_labels(t *testing.T) {
+	resourceName := "prefect_flow.flow"
+	workspaceResourceName := "prefect_workspace.workspace"
+	randomName := testutils.NewRandomPrefixedString()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check creation of the flow resource with labels
+				Config: fixtureAccFlowWithLabels(randomName, map[string]string{"team": "data", "cost-center": "1234"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "labels.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "labels.team", "data"),
+					resource.TestCheckResourceAttr(resourceName, "labels.cost-center", "1234"),
+				),
+			},
+			{
+				// Check removing one label and adding another, in place
+				Config: fixtureAccFlowWithLabels(randomName, map[string]string{"team": "data", "owner": "platform"}),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "labels.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "labels.team", "data"),
+					resource.TestCheckResourceAttr(resourceName, "labels.owner", "platform"),
+					resource.TestCheckNoResourceAttr(resourceName, "labels.cost-center"),
+				),
+			},
+			{
+				// Check removing all labels, in place
+				Config: fixtureAccFlowWithLabels(randomName, map[string]string{}),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "labels.%", "0"),
+				),
+			},
+			// Import State checks - import by ID (default)
+			{
+				ImportState:       true,
+				ImportStateIdFunc: helpers.GetResourceWorkspaceImportStateID(resourceName, workspaceResourceName),
+				ResourceName:      resourceName,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func fixtureAccFlowDefaultTags(workspace, workspaceName, name, tags string) string {
+	return fmt.Sprintf(`
+provider "prefect" {
+	default_tags = {
+		team = "data"
+		cost-center = "1234"
+	}
+}
+
+%s
+
+resource "prefect_flow" "%s" {
+	name = "%s"
+	tags = %s
+	workspace_id = prefect_workspace.%s.id
+}
+`, workspace, name, name, tags, workspaceName)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_flow_default_tags(t *testing.T) {
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+	randomName := testutils.NewRandomPrefixedString()
+	resourceName := "prefect_flow." + randomName
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check that a resource tag takes precedence over the default with the same key,
+				// while tags stays as configured
+				Config: fixtureAccFlowDefaultTags(workspace, workspaceName, randomName, `["etl", "team:platform"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.0", "etl"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.1", "team:platform"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.2", "cost-center:1234"),
+				),
+			},
+			{
+				// Check that the default applies once the resource no longer overrides it
+				Config: fixtureAccFlowDefaultTags(workspace, workspaceName, randomName, `["etl"]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.0", "etl"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.0", "etl"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.1", "cost-center:1234"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.2", "team:data"),
+				),
+			},
 		},
 	})
 }
@@ -0,0 +1,360 @@
+package resources
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var (
+	_ = resource.ResourceWithConfigure(&GlobalConcurrencyLimitResource{})
+	_ = resource.ResourceWithImportState(&GlobalConcurrencyLimitResource{})
+)
+
+// GlobalConcurrencyLimitResource contains state for the resource.
+type GlobalConcurrencyLimitResource struct {
+	client api.PrefectClient
+}
+
+// GlobalConcurrencyLimitResourceModel defines the Terraform resource model.
+type GlobalConcurrencyLimitResourceModel struct {
+	ID          types.String               `tfsdk:"id"`
+	Created     customtypes.TimestampValue `tfsdk:"created"`
+	Updated     customtypes.TimestampValue `tfsdk:"updated"`
+	AccountID   customtypes.UUIDValue      `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue      `tfsdk:"workspace_id"`
+
+	Name               types.String  `tfsdk:"name"`
+	Limit              types.Int64   `tfsdk:"limit"`
+	Active             types.Bool    `tfsdk:"active"`
+	ActiveSlots        types.Int64   `tfsdk:"active_slots"`
+	SlotDecayPerSecond types.Float64 `tfsdk:"slot_decay_per_second"`
+}
+
+// NewGlobalConcurrencyLimitResource returns a new GlobalConcurrencyLimitResource.
+//
+//nolint:ireturn // required by Terraform API
+func NewGlobalConcurrencyLimitResource() resource.Resource {
+	return &GlobalConcurrencyLimitResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *GlobalConcurrencyLimitResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_global_concurrency_limit"
+}
+
+// Configure initializes runtime state for the resource.
+func (r *GlobalConcurrencyLimitResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*helpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("resource", req.ProviderData))
+
+		return
+	}
+
+	r.client = data.Client
+}
+
+// Schema defines the schema for the resource.
+func (r *GlobalConcurrencyLimitResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The resource `global_concurrency_limit` represents a Prefect Global Concurrency Limit. " +
+			"Global concurrency limits cap how many slots of a named limit can be occupied at once, independently of tags.",
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				// We cannot use a CustomType due to a conflict with PlanModifiers; see
+				// https://github.com/hashicorp/terraform-plugin-framework/issues/763
+				// https://github.com/hashicorp/terraform-plugin-framework/issues/754
+				Description: "Global concurrency limit ID (UUID)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was created (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was updated (RFC3339)",
+			},
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the global concurrency limit. Changing the name recreates the limit, as flows refer to limits by name.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"limit": schema.Int64Attribute{
+				Description: "Maximum number of slots that can be occupied at once",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the global concurrency limit is enforced",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"slot_decay_per_second": schema.Float64Attribute{
+				Description: "Rate at which occupied slots are released, in slots per second, for limits used as rate limits. Defaults to 0, i.e. slots are only released explicitly.",
+				Optional:    true,
+				Computed:    true,
+				Default:     float64default.StaticFloat64(0),
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"active_slots": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of slots currently occupied",
+			},
+		},
+	}
+}
+
+// copyGlobalConcurrencyLimitToModel maps an API response to a model that is saved in Terraform state.
+func copyGlobalConcurrencyLimitToModel(limit *api.GlobalConcurrencyLimit, tfModel *GlobalConcurrencyLimitResourceModel) {
+	tfModel.ID = types.StringValue(limit.ID.String())
+	tfModel.Created = customtypes.NewTimestampPointerValue(limit.Created)
+	tfModel.Updated = customtypes.NewTimestampPointerValue(limit.Updated)
+
+	tfModel.Name = types.StringValue(limit.Name)
+	tfModel.Limit = types.Int64Value(limit.Limit)
+	tfModel.Active = types.BoolValue(limit.Active)
+	tfModel.ActiveSlots = types.Int64Value(limit.ActiveSlots)
+	tfModel.SlotDecayPerSecond = types.Float64Value(limit.SlotDecayPerSecond)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *GlobalConcurrencyLimitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan GlobalConcurrencyLimitResourceModel
+
+	// Populate the model from resource configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.GlobalConcurrencyLimits(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Global Concurrency Limit", err))
+
+		return
+	}
+
+	limit, err := client.Create(ctx, api.GlobalConcurrencyLimitCreate{
+		Name:               plan.Name.ValueString(),
+		Limit:              plan.Limit.ValueInt64(),
+		Active:             plan.Active.ValueBool(),
+		SlotDecayPerSecond: plan.SlotDecayPerSecond.ValueFloat64(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Global Concurrency Limit", "create", err))
+
+		return
+	}
+
+	copyGlobalConcurrencyLimitToModel(limit, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *GlobalConcurrencyLimitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state GlobalConcurrencyLimitResourceModel
+
+	// Populate the model from state and emit diagnostics on error
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.GlobalConcurrencyLimits(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Global Concurrency Limit", err))
+
+		return
+	}
+
+	limitID, err := uuid.Parse(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ParseUUIDErrorDiagnostic("Global Concurrency Limit", err))
+
+		return
+	}
+
+	limit, err := client.Get(ctx, limitID)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Global Concurrency Limit", "get", err))
+
+		return
+	}
+
+	copyGlobalConcurrencyLimitToModel(limit, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// Only the attributes that changed are sent to the API.
+func (r *GlobalConcurrencyLimitResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state GlobalConcurrencyLimitResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.GlobalConcurrencyLimits(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Global Concurrency Limit", err))
+
+		return
+	}
+
+	limitID, err := uuid.Parse(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ParseUUIDErrorDiagnostic("Global Concurrency Limit", err))
+
+		return
+	}
+
+	payload := api.GlobalConcurrencyLimitUpdate{}
+	if !plan.Limit.Equal(state.Limit) {
+		payload.Limit = plan.Limit.ValueInt64Pointer()
+	}
+	if !plan.Active.Equal(state.Active) {
+		payload.Active = plan.Active.ValueBoolPointer()
+	}
+	if !plan.SlotDecayPerSecond.Equal(state.SlotDecayPerSecond) {
+		payload.SlotDecayPerSecond = plan.SlotDecayPerSecond.ValueFloat64Pointer()
+	}
+
+	err = client.Update(ctx, limitID, payload)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Global Concurrency Limit", "update", err))
+
+		return
+	}
+
+	limit, err := client.Get(ctx, limitID)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Global Concurrency Limit", "get", err))
+
+		return
+	}
+
+	copyGlobalConcurrencyLimitToModel(limit, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *GlobalConcurrencyLimitResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state GlobalConcurrencyLimitResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.GlobalConcurrencyLimits(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Global Concurrency Limit", err))
+
+		return
+	}
+
+	limitID, err := uuid.Parse(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ParseUUIDErrorDiagnostic("Global Concurrency Limit", err))
+
+		return
+	}
+
+	err = client.Delete(ctx, limitID)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Global Concurrency Limit", "delete", err))
+
+		return
+	}
+}
+
+// ImportState imports the resource into Terraform state.
+// Valid import IDs:
+// <global_concurrency_limit_id>
+// <global_concurrency_limit_id>,<workspace_id>.
+func (r *GlobalConcurrencyLimitResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ",")
+
+	if len(parts) > 2 || len(parts) == 0 {
+		resp.Diagnostics.AddError(
+			"Error importing global concurrency limit",
+			"Import ID must be in the format of <global_concurrency_limit_id> OR <global_concurrency_limit_id>,<workspace_id>",
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[0])...)
+
+	if len(parts) == 2 && parts[1] != "" {
+		workspaceID, err := uuid.Parse(parts[1])
+		if err != nil {
+			resp.Diagnostics.Append(helpers.ParseUUIDErrorDiagnostic("Workspace", err))
+
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), workspaceID.String())...)
+	}
+}
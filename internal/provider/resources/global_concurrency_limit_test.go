@@ -0,0 +1,128 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccGlobalConcurrencyLimit(workspace, workspaceName, name string, limit int64, active bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "prefect_global_concurrency_limit" "test" {
+	name = "%s"
+	limit = %d
+	active = %t
+	slot_decay_per_second = 0.5
+	workspace_id = prefect_workspace.%s.id
+}
+`, workspace, name, limit, active, workspaceName)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_global_concurrency_limit(t *testing.T) {
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+	workspaceResourceName := "prefect_workspace." + workspaceName
+	randomName := testutils.NewRandomPrefixedString()
+	randomName2 := testutils.NewRandomPrefixedString()
+	resourceName := "prefect_global_concurrency_limit.test"
+
+	var limit api.GlobalConcurrencyLimit
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccGlobalConcurrencyLimit(workspace, workspaceName, randomName, 5, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGlobalConcurrencyLimitExists(resourceName, workspaceResourceName, &limit),
+					resource.TestCheckResourceAttr(resourceName, "name", randomName),
+					resource.TestCheckResourceAttr(resourceName, "limit", "5"),
+					resource.TestCheckResourceAttr(resourceName, "active", "true"),
+					resource.TestCheckResourceAttr(resourceName, "slot_decay_per_second", "0.5"),
+					resource.TestCheckResourceAttr(resourceName, "active_slots", "0"),
+				),
+			},
+			{
+				// Check that the limit is updated in place
+				Config: fixtureAccGlobalConcurrencyLimit(workspace, workspaceName, randomName, 10, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGlobalConcurrencyLimitExists(resourceName, workspaceResourceName, &limit),
+					resource.TestCheckResourceAttr(resourceName, "limit", "10"),
+					resource.TestCheckResourceAttr(resourceName, "active", "false"),
+					func(_ *terraform.State) error {
+						if limit.Limit != 10 || limit.Active {
+							return fmt.Errorf("expected limit to be 10 and inactive, got %d and active=%t", limit.Limit, limit.Active)
+						}
+
+						return nil
+					},
+				),
+			},
+			{
+				// Check that a name change recreates the limit
+				Config: fixtureAccGlobalConcurrencyLimit(workspace, workspaceName, randomName2, 10, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGlobalConcurrencyLimitExists(resourceName, workspaceResourceName, &limit),
+					resource.TestCheckResourceAttr(resourceName, "name", randomName2),
+				),
+			},
+			// Import State checks - import by ID (default)
+			{
+				ImportState:       true,
+				ImportStateIdFunc: helpers.GetResourceWorkspaceImportStateID(resourceName, workspaceResourceName),
+				ResourceName:      resourceName,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGlobalConcurrencyLimitExists(limitResourceName string, workspaceResourceName string, limit *api.GlobalConcurrencyLimit) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		limitResource, exists := state.RootModule().Resources[limitResourceName]
+		if !exists {
+			return fmt.Errorf("Resource not found in state: %s", limitResourceName)
+		}
+		limitID, _ := uuid.Parse(limitResource.Primary.ID)
+
+		workspaceResource, exists := state.RootModule().Resources[workspaceResourceName]
+		if !exists {
+			return fmt.Errorf("Resource not found in state: %s", workspaceResourceName)
+		}
+		workspaceID, _ := uuid.Parse(workspaceResource.Primary.ID)
+
+		// Create a new client, and use the default configurations from the environment
+		c, _ := testutils.NewTestClient()
+		limitsClient, _ := c.GlobalConcurrencyLimits(uuid.Nil, workspaceID)
+
+		fetchedLimit, err := limitsClient.Get(context.Background(), limitID)
+		if err != nil {
+			return fmt.Errorf("Error fetching global concurrency limit: %w", err)
+		}
+
+		*limit = *fetchedLimit
+
+		return nil
+	}
+}
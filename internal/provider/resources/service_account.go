@@ -31,10 +31,12 @@ const (
 var (
 	_ = resource.ResourceWithConfigure(&ServiceAccountResource{})
 	_ = resource.ResourceWithImportState(&ServiceAccountResource{})
+	_ = resource.ResourceWithModifyPlan(&ServiceAccountResource{})
 )
 
 type ServiceAccountResource struct {
-	client api.PrefectClient
+	client   api.PrefectClient
+	settings helpers.ProviderSettings
 }
 
 type ServiceAccountResourceModel struct {
@@ -53,6 +55,7 @@ type ServiceAccountResourceModel struct {
 	APIKeyExpiration       customtypes.TimestampValue `tfsdk:"api_key_expiration"`
 	OldKeyExpiresInSeconds types.Int32                `tfsdk:"old_key_expires_in_seconds"`
 	APIKey                 types.String               `tfsdk:"api_key"`
+	APIKeyKeepers          types.Map                  `tfsdk:"api_key_keepers"`
 }
 
 // ArePointerTimesEqual is a helper to compare equality of two pointer times
@@ -77,14 +80,15 @@ func (r *ServiceAccountResource) Configure(_ context.Context, req resource.Confi
 		return
 	}
 
-	client, ok := req.ProviderData.(api.PrefectClient)
+	data, ok := req.ProviderData.(*helpers.ProviderData)
 	if !ok {
 		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("resource", req.ProviderData))
 
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.settings = data.Settings
 }
 
 func (r *ServiceAccountResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -175,6 +179,11 @@ func (r *ServiceAccountResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Description: "API Key associated with the service account",
 				Sensitive:   true,
 			},
+			"api_key_keepers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Arbitrary map of values that, when changed, will trigger a rotation of the API Key. Use this to rotate the key on demand, independently of `api_key_expiration`.",
+			},
 		},
 	}
 }
@@ -199,6 +208,27 @@ func copyServiceAccountResponseToModel(serviceAccount *api.ServiceAccount, tfMod
 	tfModel.APIKeyExpiration = customtypes.NewTimestampPointerValue(serviceAccount.APIKey.Expiration)
 }
 
+// ModifyPlan warns when the planned API Key expires within the provider's
+// api_key_expiration_warning_days, so the key can be rotated ahead of time.
+func (r *ServiceAccountResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to warn about on destroy.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan ServiceAccountResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(helpers.APIKeyExpirationWarningDiagnostics(
+		path.Root("api_key_expiration"),
+		plan.APIKeyExpiration.ValueTimePointer(),
+		r.settings.APIKeyExpirationWarningDays,
+	)...)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *ServiceAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan ServiceAccountResourceModel
@@ -428,12 +458,12 @@ func (r *ServiceAccountResource) Update(ctx context.Context, req resource.Update
 	}
 
 	// Practitioners can rotate their Service Account API Key my modifying the
-	// `api_key_expiration` attribute. If the provided value is different than the current
-	// value, we'll call the RotateKey method on the client, which returns the
+	// `api_key_expiration` or `api_key_keepers` attributes. If either is different than
+	// the current value, we'll call the RotateKey method on the client, which returns the
 	// ServiceAccount object with the new API Key value included in the response.
 	providedExpiration := plan.APIKeyExpiration.ValueTimePointer()
 	currentExpiration := serviceAccount.APIKey.Expiration
-	if !ArePointerTimesEqual(providedExpiration, currentExpiration) {
+	if !ArePointerTimesEqual(providedExpiration, currentExpiration) || !plan.APIKeyKeepers.Equal(state.APIKeyKeepers) {
 		serviceAccount, err = client.RotateKey(ctx, plan.ID.ValueString(), api.ServiceAccountRotateKeyRequest{
 			APIKeyExpiration:       providedExpiration,
 			OldKeyExpiresInSeconds: plan.OldKeyExpiresInSeconds.ValueInt32(),
@@ -7,10 +7,13 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/resources"
 	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
 )
@@ -41,6 +44,40 @@ func TestPointerTimeEqualityHelper(t *testing.T) {
 	}
 }
 
+func TestAPIKeyExpirationWarningHelper(t *testing.T) {
+	t.Parallel()
+	soon := time.Now().AddDate(0, 0, 5)
+	later := time.Now().AddDate(0, 0, 60)
+	expired := time.Now().AddDate(0, 0, -1)
+
+	cases := []struct {
+		expiration *time.Time
+		days       int64
+		want       string
+	}{
+		{nil, 30, ""},
+		{&soon, 0, ""},
+		{&soon, 30, "API Key expires soon"},
+		{&later, 30, ""},
+		{&expired, 30, "API Key expired"},
+	}
+
+	for _, c := range cases {
+		diags := helpers.APIKeyExpirationWarningDiagnostics(path.Root("api_key_expiration"), c.expiration, c.days)
+
+		got := ""
+		if len(diags) > 0 {
+			got = diags[0].Summary()
+		}
+		if got != c.want {
+			t.Fatalf("expiration %v within %d days should warn with %q, but got %q", c.expiration, c.days, c.want, got)
+		}
+		if diags.HasError() {
+			t.Fatalf("expiration %v within %d days should only warn, but got an error", c.expiration, c.days)
+		}
+	}
+}
+
 func fixtureAccServiceAccountResource(name string) string {
 	return fmt.Sprintf(`
 resource "prefect_service_account" "bot" {
@@ -64,6 +101,16 @@ resource "prefect_service_account" "bot" {
 }`, name, roleName)
 }
 
+func fixtureAccServiceAccountResourceKeepers(name string, rotation string) string {
+	return fmt.Sprintf(`
+resource "prefect_service_account" "bot" {
+	name = "%s"
+	api_key_keepers = {
+		rotation = "%s"
+	}
+}`, name, rotation)
+}
+
 //nolint:paralleltest // we use the resource.ParallelTest helper instead
 func TestAccResource_service_account(t *testing.T) {
 	botResourceName := "prefect_service_account.bot"
@@ -236,3 +283,89 @@ func testAccCheckServiceAccountAPIKeyRotated(n string, passedKey *string) resour
 		return nil
 	}
 }
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_service_account_key_keepers(t *testing.T) {
+	botResourceName := "prefect_service_account.bot"
+	botRandomName := testutils.NewRandomPrefixedString()
+
+	var apiKey string
+	var bot api.ServiceAccount
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccServiceAccountResourceKeepers(botRandomName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceAccountResourceExists(botResourceName, &bot),
+					textAccCheckServiceAccountAPIKeyStored(botResourceName, &apiKey),
+					resource.TestCheckResourceAttr(botResourceName, "api_key_keepers.rotation", "1"),
+				),
+			},
+			{
+				// Ensure an unchanged keepers map DOESN'T trigger a key rotation
+				Config: fixtureAccServiceAccountResourceKeepers(botRandomName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceAccountAPIKeyUnchanged(botResourceName, &apiKey),
+				),
+			},
+			{
+				// Ensure that a keepers change DOES trigger a key rotation, without replacing the service account
+				Config: fixtureAccServiceAccountResourceKeepers(botRandomName, "2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(botResourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceAccountAPIKeyRotated(botResourceName, &apiKey),
+					func(s *terraform.State) error {
+						if id := s.RootModule().Resources[botResourceName].Primary.ID; id != bot.ID.String() {
+							return fmt.Errorf("expected Service Account %s to be kept, got %s", bot.ID, id)
+						}
+
+						return nil
+					},
+					resource.TestCheckResourceAttr(botResourceName, "api_key_keepers.rotation", "2"),
+				),
+			},
+		},
+	})
+}
+
+func fixtureAccServiceAccountResourceExpirationWarning(name string, expiration time.Time) string {
+	return fmt.Sprintf(`
+provider "prefect" {
+	api_key_expiration_warning_days = 30
+}
+
+resource "prefect_service_account" "bot" {
+	name = "%s"
+	api_key_expiration = "%s"
+}`, name, expiration.Format(time.RFC3339))
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_service_account_key_expiration_warning(t *testing.T) {
+	botResourceName := "prefect_service_account.bot"
+	botRandomName := testutils.NewRandomPrefixedString()
+	expiration := time.Now().AddDate(0, 0, 5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// The expiration is within the warning threshold, which is surfaced as a
+				// plan-time warning (covered by TestAPIKeyExpirationWarningHelper).
+				// Check that the warning doesn't block the apply.
+				Config: fixtureAccServiceAccountResourceExpirationWarning(botRandomName, expiration),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(botResourceName, "api_key_expiration"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,364 @@
+package resources
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var (
+	_ = resource.ResourceWithConfigure(&TagConcurrencyLimitResource{})
+	_ = resource.ResourceWithImportState(&TagConcurrencyLimitResource{})
+)
+
+// TagConcurrencyLimitResource contains state for the resource.
+type TagConcurrencyLimitResource struct {
+	client api.PrefectClient
+}
+
+// TagConcurrencyLimitResourceModel defines the Terraform resource model.
+type TagConcurrencyLimitResourceModel struct {
+	ID          types.String               `tfsdk:"id"`
+	Created     customtypes.TimestampValue `tfsdk:"created"`
+	Updated     customtypes.TimestampValue `tfsdk:"updated"`
+	AccountID   customtypes.UUIDValue      `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue      `tfsdk:"workspace_id"`
+
+	Tag              types.String `tfsdk:"tag"`
+	ConcurrencyLimit types.Int64  `tfsdk:"concurrency_limit"`
+	ActiveSlots      types.List   `tfsdk:"active_slots"`
+}
+
+// NewTagConcurrencyLimitResource returns a new TagConcurrencyLimitResource.
+//
+//nolint:ireturn // required by Terraform API
+func NewTagConcurrencyLimitResource() resource.Resource {
+	return &TagConcurrencyLimitResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *TagConcurrencyLimitResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tag_concurrency_limit"
+}
+
+// Configure initializes runtime state for the resource.
+func (r *TagConcurrencyLimitResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*helpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("resource", req.ProviderData))
+
+		return
+	}
+
+	r.client = data.Client
+}
+
+// Schema defines the schema for the resource.
+func (r *TagConcurrencyLimitResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The resource `tag_concurrency_limit` represents a Prefect tag-based Concurrency Limit. " +
+			"Tag-based concurrency limits cap how many task runs with a given tag can run at once. " +
+			"Limits are keyed by tag, so creating a limit for a tag that already has one takes over and updates the existing limit.",
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				// We cannot use a CustomType due to a conflict with PlanModifiers; see
+				// https://github.com/hashicorp/terraform-plugin-framework/issues/763
+				// https://github.com/hashicorp/terraform-plugin-framework/issues/754
+				Description: "Concurrency limit ID (UUID)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was created (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was updated (RFC3339)",
+			},
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"tag": schema.StringAttribute{
+				Description: "Tag that the concurrency limit applies to. Changing the tag recreates the limit.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"concurrency_limit": schema.Int64Attribute{
+				Description: "Maximum number of task runs with the tag that can run at once",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"active_slots": schema.ListAttribute{
+				Computed:    true,
+				Description: "IDs (UUID) of the task runs currently occupying slots of the limit",
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// copyTagConcurrencyLimitToModel maps an API response to a model that is saved in Terraform state.
+func copyTagConcurrencyLimitToModel(ctx context.Context, limit *api.TagConcurrencyLimit, tfModel *TagConcurrencyLimitResourceModel) diag.Diagnostics {
+	tfModel.ID = types.StringValue(limit.ID.String())
+	tfModel.Created = customtypes.NewTimestampPointerValue(limit.Created)
+	tfModel.Updated = customtypes.NewTimestampPointerValue(limit.Updated)
+
+	tfModel.Tag = types.StringValue(limit.Tag)
+	tfModel.ConcurrencyLimit = types.Int64Value(limit.ConcurrencyLimit)
+
+	activeSlots := make([]string, 0, len(limit.ActiveSlots))
+	for _, taskRunID := range limit.ActiveSlots {
+		activeSlots = append(activeSlots, taskRunID.String())
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.StringType, activeSlots)
+	if diags.HasError() {
+		return diags
+	}
+	tfModel.ActiveSlots = list
+
+	return nil
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *TagConcurrencyLimitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TagConcurrencyLimitResourceModel
+
+	// Populate the model from resource configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.TagConcurrencyLimits(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Concurrency Limit", err))
+
+		return
+	}
+
+	limit, err := client.Upsert(ctx, api.TagConcurrencyLimitUpsert{
+		Tag:              plan.Tag.ValueString(),
+		ConcurrencyLimit: plan.ConcurrencyLimit.ValueInt64(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Concurrency Limit", "create", err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(copyTagConcurrencyLimitToModel(ctx, limit, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *TagConcurrencyLimitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TagConcurrencyLimitResourceModel
+
+	// Populate the model from state and emit diagnostics on error
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.TagConcurrencyLimits(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Concurrency Limit", err))
+
+		return
+	}
+
+	// Always prefer to refresh state using the ID, if it is set.
+	//
+	// If we are importing by tag, then we will need to load once using the tag.
+	var limit *api.TagConcurrencyLimit
+
+	switch {
+	case !state.ID.IsNull():
+		var limitID uuid.UUID
+		limitID, err = uuid.Parse(state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.Append(helpers.ParseUUIDErrorDiagnostic("Concurrency Limit", err))
+
+			return
+		}
+		limit, err = client.Get(ctx, limitID)
+	case !state.Tag.IsNull():
+		limit, err = client.GetByTag(ctx, state.Tag.ValueString())
+	default:
+		resp.Diagnostics.AddError(
+			"Both ID and Tag are unset",
+			"This is a bug in the Terraform provider. Please report it to the maintainers.",
+		)
+
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Concurrency Limit", "get", err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(copyTagConcurrencyLimitToModel(ctx, limit, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// As limits are keyed by tag, the limit is updated by upserting it again.
+func (r *TagConcurrencyLimitResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TagConcurrencyLimitResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.TagConcurrencyLimits(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Concurrency Limit", err))
+
+		return
+	}
+
+	limit, err := client.Upsert(ctx, api.TagConcurrencyLimitUpsert{
+		Tag:              plan.Tag.ValueString(),
+		ConcurrencyLimit: plan.ConcurrencyLimit.ValueInt64(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Concurrency Limit", "update", err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(copyTagConcurrencyLimitToModel(ctx, limit, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *TagConcurrencyLimitResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TagConcurrencyLimitResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.TagConcurrencyLimits(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Concurrency Limit", err))
+
+		return
+	}
+
+	limitID, err := uuid.Parse(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ParseUUIDErrorDiagnostic("Concurrency Limit", err))
+
+		return
+	}
+
+	err = client.Delete(ctx, limitID)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Concurrency Limit", "delete", err))
+
+		return
+	}
+}
+
+// ImportState imports the resource into Terraform state.
+// Valid import IDs:
+// tag/<tag>
+// tag/<tag>,<workspace_id>
+// <concurrency_limit_id>
+// <concurrency_limit_id>,<workspace_id>.
+func (r *TagConcurrencyLimitResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ",")
+
+	if len(parts) > 2 || len(parts) == 0 {
+		resp.Diagnostics.AddError(
+			"Error importing concurrency limit",
+			"Import ID must be in the format of <concurrency limit identifier> OR <concurrency limit identifier>,<workspace_id>",
+		)
+
+		return
+	}
+
+	limitIdentifier := parts[0]
+
+	if strings.HasPrefix(limitIdentifier, "tag/") {
+		tag := strings.TrimPrefix(limitIdentifier, "tag/")
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tag"), tag)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), limitIdentifier)...)
+	}
+
+	if len(parts) == 2 && parts[1] != "" {
+		workspaceID, err := uuid.Parse(parts[1])
+		if err != nil {
+			resp.Diagnostics.Append(helpers.ParseUUIDErrorDiagnostic("Workspace", err))
+
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), workspaceID.String())...)
+	}
+}
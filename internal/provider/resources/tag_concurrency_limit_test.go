@@ -0,0 +1,167 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccTagConcurrencyLimit(workspace, workspaceName, tag string, concurrencyLimit int64) string {
+	return fmt.Sprintf(`
+%s
+
+resource "prefect_tag_concurrency_limit" "test" {
+	tag = "%s"
+	concurrency_limit = %d
+	workspace_id = prefect_workspace.%s.id
+}
+`, workspace, tag, concurrencyLimit, workspaceName)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_tag_concurrency_limit(t *testing.T) {
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+	workspaceResourceName := "prefect_workspace." + workspaceName
+	randomTag := testutils.NewRandomPrefixedString()
+	existingTag := testutils.NewRandomPrefixedString()
+	resourceName := "prefect_tag_concurrency_limit.test"
+
+	var limit api.TagConcurrencyLimit
+	var existingLimitID, workspaceID uuid.UUID
+
+	// createExistingLimit sets a limit for a tag outside of Terraform, as another tool would.
+	createExistingLimit := func() {
+		c, _ := testutils.NewTestClient()
+		limitsClient, _ := c.TagConcurrencyLimits(uuid.Nil, workspaceID)
+
+		existingLimit, err := limitsClient.Upsert(context.Background(), api.TagConcurrencyLimitUpsert{
+			Tag:              existingTag,
+			ConcurrencyLimit: 1,
+		})
+		if err != nil {
+			t.Fatalf("error creating concurrency limit out of band: %s", err)
+		}
+		existingLimitID = existingLimit.ID
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccTagConcurrencyLimit(workspace, workspaceName, randomTag, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTagConcurrencyLimitExists(resourceName, workspaceResourceName, &limit),
+					resource.TestCheckResourceAttr(resourceName, "tag", randomTag),
+					resource.TestCheckResourceAttr(resourceName, "concurrency_limit", "5"),
+					resource.TestCheckResourceAttr(resourceName, "active_slots.#", "0"),
+					func(s *terraform.State) error {
+						workspaceID, _ = uuid.Parse(s.RootModule().Resources[workspaceResourceName].Primary.ID)
+
+						return nil
+					},
+				),
+			},
+			{
+				// Check that the limit is updated in place
+				Config: fixtureAccTagConcurrencyLimit(workspace, workspaceName, randomTag, 10),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTagConcurrencyLimitExists(resourceName, workspaceResourceName, &limit),
+					resource.TestCheckResourceAttr(resourceName, "concurrency_limit", "10"),
+				),
+			},
+			// Import State checks - import by ID (default)
+			{
+				ImportState:       true,
+				ImportStateIdFunc: helpers.GetResourceWorkspaceImportStateID(resourceName, workspaceResourceName),
+				ResourceName:      resourceName,
+				ImportStateVerify: true,
+			},
+			// Import State checks - import by tag
+			{
+				ImportState:       true,
+				ImportStateIdFunc: getTagConcurrencyLimitImportStateIDByTag(randomTag, workspaceResourceName),
+				ResourceName:      resourceName,
+				ImportStateVerify: true,
+			},
+			{
+				// Check that changing the tag to one that already has a limit
+				// recreates the resource, taking over the existing limit
+				PreConfig: createExistingLimit,
+				Config:    fixtureAccTagConcurrencyLimit(workspace, workspaceName, existingTag, 3),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTagConcurrencyLimitExists(resourceName, workspaceResourceName, &limit),
+					resource.TestCheckResourceAttr(resourceName, "tag", existingTag),
+					resource.TestCheckResourceAttr(resourceName, "concurrency_limit", "3"),
+					func(_ *terraform.State) error {
+						if limit.ID != existingLimitID {
+							return fmt.Errorf("expected the existing limit %s to be updated, but got %s", existingLimitID, limit.ID)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTagConcurrencyLimitExists(limitResourceName string, workspaceResourceName string, limit *api.TagConcurrencyLimit) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		limitResource, exists := state.RootModule().Resources[limitResourceName]
+		if !exists {
+			return fmt.Errorf("Resource not found in state: %s", limitResourceName)
+		}
+		limitID, _ := uuid.Parse(limitResource.Primary.ID)
+
+		workspaceResource, exists := state.RootModule().Resources[workspaceResourceName]
+		if !exists {
+			return fmt.Errorf("Resource not found in state: %s", workspaceResourceName)
+		}
+		workspaceID, _ := uuid.Parse(workspaceResource.Primary.ID)
+
+		// Create a new client, and use the default configurations from the environment
+		c, _ := testutils.NewTestClient()
+		limitsClient, _ := c.TagConcurrencyLimits(uuid.Nil, workspaceID)
+
+		fetchedLimit, err := limitsClient.Get(context.Background(), limitID)
+		if err != nil {
+			return fmt.Errorf("Error fetching concurrency limit: %w", err)
+		}
+
+		*limit = *fetchedLimit
+
+		return nil
+	}
+}
+
+// getTagConcurrencyLimitImportStateIDByTag generates an import ID of the
+// form `tag/<tag>,workspace_id`, to import a concurrency limit by tag.
+func getTagConcurrencyLimitImportStateIDByTag(tag string, workspaceResourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		workspace, exists := state.RootModule().Resources[workspaceResourceName]
+		if !exists {
+			return "", fmt.Errorf("resource not found in state: %s", workspaceResourceName)
+		}
+
+		return fmt.Sprintf("tag/%s,%s", tag, workspace.Primary.ID), nil
+	}
+}
@@ -2,10 +2,15 @@ package resources
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/avast/retry-go/v4"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -14,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
@@ -26,11 +32,22 @@ import (
 var (
 	_ = resource.ResourceWithConfigure(&VariableResource{})
 	_ = resource.ResourceWithImportState(&VariableResource{})
+	_ = resource.ResourceWithModifyPlan(&VariableResource{})
+	_ = resource.ResourceWithValidateConfig(&VariableResource{})
+)
+
+// Supported variable value types.
+const (
+	variableValueTypeString = "string"
+	variableValueTypeJSON   = "json"
+	variableValueTypeNumber = "number"
+	variableValueTypeBool   = "bool"
 )
 
 // VariableResource contains state for the resource.
 type VariableResource struct {
-	client api.PrefectClient
+	client   api.PrefectClient
+	settings helpers.ProviderSettings
 }
 
 // VariableResourceModel defines the Terraform resource model.
@@ -41,9 +58,11 @@ type VariableResourceModel struct {
 	AccountID   customtypes.UUIDValue      `tfsdk:"account_id"`
 	WorkspaceID customtypes.UUIDValue      `tfsdk:"workspace_id"`
 
-	Name  types.String `tfsdk:"name"`
-	Value types.String `tfsdk:"value"`
-	Tags  types.List   `tfsdk:"tags"`
+	Name      types.String `tfsdk:"name"`
+	Value     types.String `tfsdk:"value"`
+	ValueType types.String `tfsdk:"value_type"`
+	Tags      types.List   `tfsdk:"tags"`
+	TagsAll   types.List   `tfsdk:"tags_all"`
 }
 
 // NewVariableResource returns a new VariableResource.
@@ -64,14 +83,15 @@ func (r *VariableResource) Configure(_ context.Context, req resource.ConfigureRe
 		return
 	}
 
-	client, ok := req.ProviderData.(api.PrefectClient)
+	data, ok := req.ProviderData.(*helpers.ProviderData)
 	if !ok {
 		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("resource", req.ProviderData))
 
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.settings = data.Settings
 }
 
 // Schema defines the schema for the resource.
@@ -124,36 +144,153 @@ func (r *VariableResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Description: "Value of the variable",
 				Required:    true,
 			},
+			"value_type": schema.StringAttribute{
+				Description: "Type the `value` must have, one of `string`, `json`, `number` or `bool`. " +
+					"The value is checked at plan time, and sent to the API as a value of that type, e.g. a JSON object for `json`. " +
+					"Equivalent values returned by the API, e.g. JSON with different whitespace, don't show up as drift. " +
+					"When unset, the value is sent as a string.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(variableValueTypeString, variableValueTypeJSON, variableValueTypeNumber, variableValueTypeBool),
+				},
+			},
 			"tags": schema.ListAttribute{
-				Description: "Tags associated with the variable",
+				Description: "Tags associated with the variable. The provider's `default_tags` are merged into `tags_all`.",
 				ElementType: types.StringType,
 				Optional:    true,
 				Computed:    true,
 				Default:     listdefault.StaticValue(defaultEmptyTagList),
 			},
+			"tags_all": schema.ListAttribute{
+				Description: "All tags of the variable, i.e. `tags` with the provider's `default_tags` merged in.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
 		},
 	}
 }
 
 // copyVariableToModel maps an API response to a model that is saved in Terraform state.
 // A model can be a Terraform Plan, State, or Config object.
-func copyVariableToModel(ctx context.Context, variable *api.Variable, tfModel *VariableResourceModel) diag.Diagnostics {
+func copyVariableToModel(ctx context.Context, variable *api.Variable, tfModel *VariableResourceModel, defaultTags map[string]string) diag.Diagnostics {
 	tfModel.ID = types.StringValue(variable.ID.String())
 	tfModel.Created = customtypes.NewTimestampPointerValue(variable.Created)
 	tfModel.Updated = customtypes.NewTimestampPointerValue(variable.Updated)
 
 	tfModel.Name = types.StringValue(variable.Name)
-	tfModel.Value = types.StringValue(variable.Value)
 
-	tags, diags := types.ListValueFrom(ctx, types.StringType, variable.Tags)
+	// The value of a json variable is JSON, including a JSON string or null,
+	// which the API's value would otherwise lose the quotes of.
+	value := variable.Value
+	if tfModel.ValueType.ValueString() == variableValueTypeJSON && len(variable.RawValue) != 0 {
+		value = string(variable.RawValue)
+	}
+
+	// The configured value is kept when the API returns an equivalent one.
+	if tfModel.Value.IsNull() || tfModel.Value.IsUnknown() || !variableValuesEqual(tfModel.Value.ValueString(), value, tfModel.ValueType.ValueString()) {
+		tfModel.Value = types.StringValue(value)
+	}
+
+	tags, tagsAll, diags := tagsFromAPI(ctx, variable.Tags, tfModel.Tags, defaultTags)
 	if diags.HasError() {
 		return diags
 	}
 	tfModel.Tags = tags
+	tfModel.TagsAll = tagsAll
 
 	return nil
 }
 
+// variableValue converts a configured value to the value sent to the API,
+// based on the variable's value type.
+func variableValue(value, valueType string) (interface{}, error) {
+	switch valueType {
+	case variableValueTypeJSON:
+		var decoded interface{}
+		if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+			return nil, fmt.Errorf("value is not valid JSON: %w", err)
+		}
+
+		return decoded, nil
+	case variableValueTypeNumber:
+		var number float64
+		if err := json.Unmarshal([]byte(value), &number); err != nil {
+			return nil, fmt.Errorf("value %q is not a number", value)
+		}
+
+		return json.Number(strings.TrimSpace(value)), nil
+	case variableValueTypeBool:
+		if value != "true" && value != "false" {
+			return nil, fmt.Errorf("value %q is not a bool, expected true or false", value)
+		}
+
+		return value == "true", nil
+	default:
+		return value, nil
+	}
+}
+
+// variableValuesEqual returns whether a configured value and the value
+// returned by the API are equivalent for the variable's value type.
+func variableValuesEqual(configured, actual, valueType string) bool {
+	switch valueType {
+	case variableValueTypeJSON:
+		var configuredValue, actualValue interface{}
+		if json.Unmarshal([]byte(configured), &configuredValue) != nil || json.Unmarshal([]byte(actual), &actualValue) != nil {
+			return false
+		}
+
+		return reflect.DeepEqual(configuredValue, actualValue)
+	case variableValueTypeNumber:
+		configuredNumber, err := strconv.ParseFloat(strings.TrimSpace(configured), 64)
+		if err != nil {
+			return false
+		}
+		actualNumber, err := strconv.ParseFloat(actual, 64)
+
+		return err == nil && configuredNumber == actualNumber
+	default:
+		return configured == actual
+	}
+}
+
+// ValidateConfig ensures that the value matches the value_type, if set.
+func (r *VariableResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config VariableResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Value.IsNull() || config.Value.IsUnknown() || config.ValueType.IsNull() || config.ValueType.IsUnknown() {
+		return
+	}
+
+	if _, err := variableValue(config.Value.ValueString(), config.ValueType.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("value"),
+			"Invalid variable value",
+			fmt.Sprintf("The value doesn't match the value_type %q: %s.", config.ValueType.ValueString(), err),
+		)
+	}
+}
+
+// ModifyPlan merges the provider's default tags into the planned tags_all.
+func (r *VariableResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to reconcile on destroy.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan VariableResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planTagsAll(ctx, plan.Tags, r.settings.DefaultTags, resp)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *VariableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan VariableResourceModel
@@ -177,12 +314,19 @@ func (r *VariableResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	value, err := variableValue(plan.Value.ValueString(), plan.ValueType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("value"), "Invalid variable value", err.Error())
+
+		return
+	}
+
 	variable, err := retry.DoWithData(
 		func() (*api.Variable, error) {
 			return client.Create(ctx, api.VariableCreate{
 				Name:  plan.Name.ValueString(),
-				Value: plan.Value.ValueString(),
-				Tags:  tags,
+				Value: value,
+				Tags:  helpers.MergeDefaultTags(tags, r.settings.DefaultTags),
 			})
 		},
 		utils.DefaultRetryOptions...,
@@ -194,7 +338,7 @@ func (r *VariableResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	resp.Diagnostics.Append(copyVariableToModel(ctx, variable, &plan)...)
+	resp.Diagnostics.Append(copyVariableToModel(ctx, variable, &plan, r.settings.DefaultTags)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -254,7 +398,7 @@ func (r *VariableResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	resp.Diagnostics.Append(copyVariableToModel(ctx, variable, &state)...)
+	resp.Diagnostics.Append(copyVariableToModel(ctx, variable, &state, r.settings.DefaultTags)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -294,10 +438,17 @@ func (r *VariableResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	value, err := variableValue(plan.Value.ValueString(), plan.ValueType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("value"), "Invalid variable value", err.Error())
+
+		return
+	}
+
 	err = client.Update(ctx, variableID, api.VariableUpdate{
 		Name:  plan.Name.ValueString(),
-		Value: plan.Value.ValueString(),
-		Tags:  tags,
+		Value: value,
+		Tags:  helpers.MergeDefaultTags(tags, r.settings.DefaultTags),
 	})
 	if err != nil {
 		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Variable", "update", err))
@@ -312,7 +463,7 @@ func (r *VariableResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	resp.Diagnostics.Append(copyVariableToModel(ctx, variable, &plan)...)
+	resp.Diagnostics.Append(copyVariableToModel(ctx, variable, &plan, r.settings.DefaultTags)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -3,6 +3,12 @@ package resources_test
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
 	"testing"
 
 	"github.com/google/uuid"
@@ -96,6 +102,117 @@ func TestAccResource_variable(t *testing.T) {
 	})
 }
 
+func fixtureAccVariableResourceValueType(workspace, workspaceName, name, valueType, value string) string {
+	return fmt.Sprintf(`
+%s
+resource "prefect_variable" "%s" {
+	name = "%s"
+	value_type = "%s"
+	value = %s
+	workspace_id = prefect_workspace.%s.id
+}
+	`, workspace, name, name, valueType, value, workspaceName)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_variable_value_type(t *testing.T) {
+	randomName := testutils.NewRandomPrefixedString()
+	resourceName := "prefect_variable." + randomName
+
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+	workspaceResourceName := "prefect_workspace." + workspaceName
+
+	var variable api.Variable
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Any value is a valid string, so there is no mismatching string value
+			{
+				Config: fixtureAccVariableResourceValueType(workspace, workspaceName, randomName, "string", `"{\"a\": 1}"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVariableExists(resourceName, workspaceResourceName, &variable),
+					testAccCheckVariableValues(&variable, &api.Variable{Name: randomName, Value: `{"a": 1}`}),
+					resource.TestCheckResourceAttr(resourceName, "value", `{"a": 1}`),
+				),
+			},
+			{
+				Config:      fixtureAccVariableResourceValueType(workspace, workspaceName, randomName, "json", `"{not json"`),
+				ExpectError: regexp.MustCompile(`Invalid variable value`),
+			},
+			{
+				// Check that the API's encoding of the object, without whitespace,
+				// doesn't show up as drift
+				Config: fixtureAccVariableResourceValueType(workspace, workspaceName, randomName, "json", `"{ \"a\": 1, \"b\": [true, \"x\"] }"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVariableExists(resourceName, workspaceResourceName, &variable),
+					testAccCheckVariableValues(&variable, &api.Variable{Name: randomName, Value: `{"a":1,"b":[true,"x"]}`}),
+					resource.TestCheckResourceAttr(resourceName, "value", `{ "a": 1, "b": [true, "x"] }`),
+				),
+			},
+			{
+				// Check that changing only the value type updates the variable
+				Config: fixtureAccVariableResourceValueType(workspace, workspaceName, randomName, "string", `"{ \"a\": 1, \"b\": [true, \"x\"] }"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVariableExists(resourceName, workspaceResourceName, &variable),
+					testAccCheckVariableValues(&variable, &api.Variable{Name: randomName, Value: `{ "a": 1, "b": [true, "x"] }`}),
+				),
+			},
+			{
+				Config: fixtureAccVariableResourceValueType(workspace, workspaceName, randomName, "json", `jsonencode({ a = 1, b = [true, "x"] })`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVariableExists(resourceName, workspaceResourceName, &variable),
+					testAccCheckVariableValues(&variable, &api.Variable{Name: randomName, Value: `{"a":1,"b":[true,"x"]}`}),
+				),
+			},
+			{
+				// Check that a JSON string keeps its quotes, rather than
+				// showing up as drift against the API's string value
+				Config: fixtureAccVariableResourceValueType(workspace, workspaceName, randomName, "json", `jsonencode("eu-west")`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVariableExists(resourceName, workspaceResourceName, &variable),
+					testAccCheckVariableValues(&variable, &api.Variable{Name: randomName, Value: "eu-west"}),
+					resource.TestCheckResourceAttr(resourceName, "value", `"eu-west"`),
+				),
+			},
+			{
+				// Check that JSON null doesn't show up as drift against an empty value
+				Config: fixtureAccVariableResourceValueType(workspace, workspaceName, randomName, "json", `jsonencode(null)`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVariableExists(resourceName, workspaceResourceName, &variable),
+					testAccCheckVariableValues(&variable, &api.Variable{Name: randomName, Value: ""}),
+					resource.TestCheckResourceAttr(resourceName, "value", "null"),
+				),
+			},
+			{
+				Config:      fixtureAccVariableResourceValueType(workspace, workspaceName, randomName, "number", `"three"`),
+				ExpectError: regexp.MustCompile(`Invalid variable value`),
+			},
+			{
+				Config: fixtureAccVariableResourceValueType(workspace, workspaceName, randomName, "number", `"3.50"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVariableExists(resourceName, workspaceResourceName, &variable),
+					testAccCheckVariableValues(&variable, &api.Variable{Name: randomName, Value: "3.5"}),
+					resource.TestCheckResourceAttr(resourceName, "value", "3.50"),
+				),
+			},
+			{
+				Config:      fixtureAccVariableResourceValueType(workspace, workspaceName, randomName, "bool", `"yes"`),
+				ExpectError: regexp.MustCompile(`Invalid variable value`),
+			},
+			{
+				Config: fixtureAccVariableResourceValueType(workspace, workspaceName, randomName, "bool", `"true"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVariableExists(resourceName, workspaceResourceName, &variable),
+					testAccCheckVariableValues(&variable, &api.Variable{Name: randomName, Value: "true"}),
+					resource.TestCheckResourceAttr(resourceName, "value", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckVariableExists(variableResourceName string, workspaceResourceName string, variable *api.Variable) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		variableResource, exists := state.RootModule().Resources[variableResourceName]
@@ -141,3 +258,201 @@ func testAccCheckVariableValues(fetchedVariable *api.Variable, valuesToCheck *ap
 		return nil
 	}
 }
+
+func fixtureAccVariableResourceRateLimited(workspace, workspaceName, name string, count int) string {
+	return fmt.Sprintf(`
+provider "prefect" {
+	rate_limit = 2
+}
+
+%s
+
+resource "prefect_variable" "%s" {
+	count = %d
+	name = "%s-${count.index}"
+	value = "value-${count.index}"
+	workspace_id = prefect_workspace.%s.id
+	depends_on = [prefect_workspace.%s]
+}
+	`, workspace, name, count, name, workspaceName, workspaceName)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_variable_rate_limit(t *testing.T) {
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+	randomName := testutils.NewRandomPrefixedString()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check that resources applied in parallel are created under a provider rate limit
+				Config: fixtureAccVariableResourceRateLimited(workspace, workspaceName, randomName, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fmt.Sprintf("prefect_variable.%s.0", randomName), "value", "value-0"),
+					resource.TestCheckResourceAttr(fmt.Sprintf("prefect_variable.%s.4", randomName), "value", "value-4"),
+				),
+			},
+		},
+	})
+}
+
+func fixtureAccVariableResourceRequestTimeout(endpoint, name string) string {
+	return fmt.Sprintf(`
+provider "prefect" {
+	endpoint = "%s"
+	request_timeout = 1
+}
+
+resource "prefect_variable" "%s" {
+	name = "%s"
+	value = "value"
+}
+	`, endpoint, name, name)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_variable_request_timeout(t *testing.T) {
+	randomName := testutils.NewRandomPrefixedString()
+
+	// The server never responds, as a hung Prefect endpoint would.
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check that a hung request fails with the URL and timeout, rather than stalling
+				Config:      fixtureAccVariableResourceRequestTimeout(server.URL, randomName),
+				ExpectError: regexp.MustCompile(`(?s)/api/variables/.*timed\s+out\s+after\s+1s`),
+			},
+		},
+	})
+}
+
+func fixtureAccVariableResourceEndpoint(endpoint, name string) string {
+	return fmt.Sprintf(`
+provider "prefect" {
+	endpoint = "%s"
+}
+
+resource "prefect_variable" "%s" {
+	name = "%s"
+	value = "value"
+}
+	`, endpoint, name, name)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_variable_error_detail(t *testing.T) {
+	randomName := testutils.NewRandomPrefixedString()
+
+	// The server rejects every request, as Prefect does for invalid payloads.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{
+			"exception_message": "Invalid request received.",
+			"exception_detail": [{"loc": ["body", "name"], "msg": "name_must_be_valid", "type": "value_error"}],
+			"request_body": {"name": "secret-request-body"}
+		}`))
+	}))
+	defer server.Close()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check that the validation error returned by the API shows up in the diagnostic
+				Config:      fixtureAccVariableResourceEndpoint(server.URL, randomName),
+				ExpectError: regexp.MustCompile(`body\.name:\s+name_must_be_valid`),
+			},
+		},
+	})
+}
+
+func fixtureAccVariableResourceAPIKeyFile(endpoint, apiKeyFile, name string) string {
+	return fmt.Sprintf(`
+provider "prefect" {
+	endpoint = "%s"
+	api_key = "static-key"
+	api_key_file = "%s"
+}
+
+resource "prefect_variable" "%s" {
+	name = "%s"
+	value = "value"
+}
+	`, endpoint, apiKeyFile, name, name)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_variable_api_key_file(t *testing.T) {
+	randomName := testutils.NewRandomPrefixedString()
+	apiKeyFile := filepath.Join(t.TempDir(), "api-key")
+	variableID := uuid.New()
+
+	var mu sync.Mutex
+	currentKey := "key-1"
+
+	writeAPIKey := func(key string) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		currentKey = key
+		if err := os.WriteFile(apiKeyFile, []byte(key+"\n"), 0o600); err != nil {
+			t.Fatalf("error writing API key file: %s", err)
+		}
+	}
+	writeAPIKey("key-1")
+
+	// The server only accepts the key currently written to the file,
+	// as it would once a rotated key has replaced the previous one.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		authorized := r.Header.Get("Authorization") == "Bearer "+currentKey
+		mu.Unlock()
+
+		if !authorized {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"id": "%s", "name": "%s", "value": "value", "tags": []}`, variableID, randomName)
+	}))
+	defer server.Close()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check that requests are authenticated with the key in the file, rather than the static key
+				Config: fixtureAccVariableResourceAPIKeyFile(server.URL, apiKeyFile, randomName),
+				Check:  resource.TestCheckResourceAttr("prefect_variable."+randomName, "id", variableID.String()),
+			},
+			{
+				// Check that a key rotated mid-session is read for the next request
+				PreConfig: func() { writeAPIKey("key-2") },
+				Config:    fixtureAccVariableResourceAPIKeyFile(server.URL, apiKeyFile, randomName),
+				Check:     resource.TestCheckResourceAttr("prefect_variable."+randomName, "id", variableID.String()),
+			},
+		},
+	})
+}
@@ -0,0 +1,446 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = resource.ResourceWithConfigure(&VariablesResource{})
+
+// VariablesResource contains state for the resource.
+type VariablesResource struct {
+	client   api.PrefectClient
+	settings helpers.ProviderSettings
+}
+
+// VariablesResourceModel defines the Terraform resource model.
+type VariablesResourceModel struct {
+	ID          types.String          `tfsdk:"id"`
+	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+
+	Variables   types.Map `tfsdk:"variables"`
+	VariableIDs types.Map `tfsdk:"variable_ids"`
+}
+
+// managedVariable is a variable managed by the resource,
+// keyed by name in the reconciliation maps. The value is JSON-encoded.
+type managedVariable struct {
+	ID    string
+	Value string
+}
+
+// NewVariablesResource returns a new VariablesResource.
+//
+//nolint:ireturn // required by Terraform API
+func NewVariablesResource() resource.Resource {
+	return &VariablesResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *VariablesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_variables"
+}
+
+// Configure initializes runtime state for the resource.
+func (r *VariablesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*helpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("resource", req.ProviderData))
+
+		return
+	}
+
+	r.client = data.Client
+	r.settings = data.Settings
+}
+
+// Schema defines the schema for the resource.
+func (r *VariablesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The resource `variables` manages a set of Prefect Cloud Variables from a single map. " +
+			"Adding a key creates a variable, changing a value updates it, and removing a key deletes the variable. " +
+			"Variables are tagged with the provider's `default_tags` when they're created or updated. " +
+			"Values are JSON-encoded, e.g. with `jsonencode()`, so each variable can hold a string, number, bool, object or list. " +
+			"Use `prefect_variable` instead if you need to manage tags on individual variables.",
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier for this set of variables (UUID)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"variables": schema.MapAttribute{
+				Description: "Map of variable names to JSON-encoded values, e.g. `jsonencode(\"value\")` for a string. " +
+					"Equivalent JSON returned by the API, e.g. with different whitespace, doesn't show up as drift.",
+				ElementType: jsontypes.NormalizedType{},
+				Required:    true,
+			},
+			"variable_ids": schema.MapAttribute{
+				Description: "Map of variable names to their IDs (UUID)",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// managedVariablesFromModel builds the set of variables currently
+// tracked in a model, using the stored values and IDs.
+func managedVariablesFromModel(ctx context.Context, model *VariablesResourceModel) (map[string]managedVariable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	values := map[string]string{}
+	ids := map[string]string{}
+	diags.Append(model.Variables.ElementsAs(ctx, &values, false)...)
+	diags.Append(model.VariableIDs.ElementsAs(ctx, &ids, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	current := make(map[string]managedVariable, len(ids))
+	for name, id := range ids {
+		current[name] = managedVariable{ID: id, Value: values[name]}
+	}
+
+	return current, diags
+}
+
+// copyManagedVariablesToModel maps the reconciled variables to the model.
+func copyManagedVariablesToModel(ctx context.Context, variables map[string]managedVariable, model *VariablesResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	values := make(map[string]string, len(variables))
+	ids := make(map[string]string, len(variables))
+	for name, variable := range variables {
+		values[name] = variable.Value
+		ids[name] = variable.ID
+	}
+
+	variablesValue, d := types.MapValueFrom(ctx, jsontypes.NormalizedType{}, values)
+	diags.Append(d...)
+	variableIDsValue, d := types.MapValueFrom(ctx, types.StringType, ids)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	model.Variables = variablesValue
+	model.VariableIDs = variableIDsValue
+
+	return diags
+}
+
+// reconcileVariables creates, updates and deletes variables so that the
+// current set matches the desired one. Created and updated variables are
+// tagged with the given tags, i.e. the provider's default tags.
+//
+// Values are compared as JSON, so equivalent values aren't updated.
+//
+// Every variable is attempted even if an earlier one fails, and the returned
+// map only reflects the operations that succeeded. This way, the state
+// saved after a partial failure is accurate and the next apply picks up
+// where this one left off.
+func reconcileVariables(ctx context.Context, client api.VariablesClient, current map[string]managedVariable, desired map[string]string, tags []string) (map[string]managedVariable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	result := make(map[string]managedVariable, len(current))
+	for name, variable := range current {
+		result[name] = variable
+	}
+
+	// Sort the names so operations happen in a predictable order.
+	names := make([]string, 0, len(current)+len(desired))
+	for name := range current {
+		if _, ok := desired[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	for name := range desired {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		existing, exists := current[name]
+		value, wanted := desired[name]
+
+		switch {
+		case exists && !wanted:
+			variableID, err := uuid.Parse(existing.ID)
+			if err != nil {
+				diags.Append(helpers.ParseUUIDErrorDiagnostic("Variable", err))
+
+				continue
+			}
+
+			if err := client.Delete(ctx, variableID); err != nil {
+				diags.Append(variableReconcileErrorDiagnostic(name, "delete", err))
+
+				continue
+			}
+			delete(result, name)
+
+		case exists && !variableValuesEqual(value, existing.Value, variableValueTypeJSON):
+			variableID, err := uuid.Parse(existing.ID)
+			if err != nil {
+				diags.Append(helpers.ParseUUIDErrorDiagnostic("Variable", err))
+
+				continue
+			}
+
+			decoded, err := variableValue(value, variableValueTypeJSON)
+			if err != nil {
+				diags.Append(variableReconcileErrorDiagnostic(name, "update", err))
+
+				continue
+			}
+
+			err = client.Update(ctx, variableID, api.VariableUpdate{
+				Name:  name,
+				Value: decoded,
+				Tags:  tags,
+			})
+			if err != nil {
+				diags.Append(variableReconcileErrorDiagnostic(name, "update", err))
+
+				continue
+			}
+			result[name] = managedVariable{ID: existing.ID, Value: value}
+
+		case !exists:
+			decoded, err := variableValue(value, variableValueTypeJSON)
+			if err != nil {
+				diags.Append(variableReconcileErrorDiagnostic(name, "create", err))
+
+				continue
+			}
+
+			variable, err := client.Create(ctx, api.VariableCreate{
+				Name:  name,
+				Value: decoded,
+				Tags:  tags,
+			})
+			if err != nil {
+				diags.Append(variableReconcileErrorDiagnostic(name, "create", err))
+
+				continue
+			}
+			result[name] = managedVariable{ID: variable.ID.String(), Value: value}
+		}
+	}
+
+	return result, diags
+}
+
+// variableReconcileErrorDiagnostic returns an error diagnostic naming the
+// variable that failed, so partial failures are easy to pinpoint.
+//
+//nolint:ireturn // required by Terraform API
+func variableReconcileErrorDiagnostic(name string, operation string, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		fmt.Sprintf("Error during %s Variable %q", operation, name),
+		fmt.Sprintf("Could not %s Variable %q, unexpected error: %s. "+
+			"Other variables in this resource were still reconciled; run apply again once the issue is resolved.", operation, name, err.Error()),
+	)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *VariablesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan VariablesResourceModel
+
+	// Populate the model from resource configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var desired map[string]string
+	resp.Diagnostics.Append(plan.Variables.ElementsAs(ctx, &desired, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.Variables(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Variable", err))
+
+		return
+	}
+
+	variables, diags := reconcileVariables(ctx, client, map[string]managedVariable{}, desired, helpers.MergeDefaultTags([]string{}, r.settings.DefaultTags))
+	resp.Diagnostics.Append(diags...)
+
+	plan.ID = types.StringValue(uuid.New().String())
+	resp.Diagnostics.Append(copyManagedVariablesToModel(ctx, variables, &plan)...)
+
+	// Save whatever was created, even on partial failure,
+	// so those variables aren't orphaned outside of state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *VariablesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state VariablesResourceModel
+
+	// Populate the model from state and emit diagnostics on error
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.Variables(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Variable", err))
+
+		return
+	}
+
+	current, diags := managedVariablesFromModel(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for name, managed := range current {
+		variableID, err := uuid.Parse(managed.ID)
+		if err != nil {
+			resp.Diagnostics.Append(helpers.ParseUUIDErrorDiagnostic("Variable", err))
+
+			return
+		}
+
+		// A variable deleted outside of Terraform is no longer managed,
+		// so it's planned to be created again.
+		variable, err := client.Get(ctx, variableID)
+		if errors.Is(err, api.ErrNotFound) {
+			delete(current, name)
+
+			continue
+		}
+		if err != nil {
+			resp.Diagnostics.Append(variableReconcileErrorDiagnostic(name, "get", err))
+
+			return
+		}
+
+		// Keep the value from state if it's equivalent,
+		// so formatting differences don't show up as drift.
+		value := string(variable.RawValue)
+		if variableValuesEqual(managed.Value, value, variableValueTypeJSON) {
+			value = managed.Value
+		}
+
+		current[name] = managedVariable{ID: variable.ID.String(), Value: value}
+	}
+
+	resp.Diagnostics.Append(copyManagedVariablesToModel(ctx, current, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *VariablesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state VariablesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var desired map[string]string
+	resp.Diagnostics.Append(plan.Variables.ElementsAs(ctx, &desired, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, diags := managedVariablesFromModel(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.Variables(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Variable", err))
+
+		return
+	}
+
+	variables, diags := reconcileVariables(ctx, client, current, desired, helpers.MergeDefaultTags([]string{}, r.settings.DefaultTags))
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(copyManagedVariablesToModel(ctx, variables, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *VariablesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state VariablesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, diags := managedVariablesFromModel(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.Variables(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Variable", err))
+
+		return
+	}
+
+	remaining, diags := reconcileVariables(ctx, client, current, map[string]string{}, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		// Keep the variables that could not be deleted in state.
+		resp.Diagnostics.Append(copyManagedVariablesToModel(ctx, remaining, &state)...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+		return
+	}
+}
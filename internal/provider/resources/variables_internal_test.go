@@ -0,0 +1,154 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+// fakeVariablesClient is an in-memory api.VariablesClient
+// that fails every operation on the variables named in failing.
+type fakeVariablesClient struct {
+	failing   map[string]bool
+	variables map[uuid.UUID]api.Variable
+}
+
+var errFakeVariablesClient = errors.New("server error")
+
+func newFakeVariablesClient(failing ...string) *fakeVariablesClient {
+	client := &fakeVariablesClient{failing: map[string]bool{}, variables: map[uuid.UUID]api.Variable{}}
+	for _, name := range failing {
+		client.failing[name] = true
+	}
+
+	return client
+}
+
+func (c *fakeVariablesClient) add(name, value string) string {
+	variable := api.Variable{Name: name, Value: value}
+	variable.ID = uuid.New()
+	c.variables[variable.ID] = variable
+
+	return variable.ID.String()
+}
+
+func (c *fakeVariablesClient) Create(_ context.Context, data api.VariableCreate) (*api.Variable, error) {
+	if c.failing[data.Name] {
+		return nil, errFakeVariablesClient
+	}
+
+	variable := api.Variable{Name: data.Name, Tags: data.Tags}
+	variable.ID = uuid.New()
+	c.variables[variable.ID] = variable
+
+	return &variable, nil
+}
+
+func (c *fakeVariablesClient) Get(_ context.Context, variableID uuid.UUID) (*api.Variable, error) {
+	variable, ok := c.variables[variableID]
+	if !ok {
+		return nil, api.ErrNotFound
+	}
+
+	return &variable, nil
+}
+
+func (c *fakeVariablesClient) GetByName(_ context.Context, _ string) (*api.Variable, error) {
+	return nil, api.ErrNotFound
+}
+
+func (c *fakeVariablesClient) List(_ context.Context, _ api.VariableFilter) ([]api.Variable, error) {
+	return nil, nil
+}
+
+func (c *fakeVariablesClient) Update(_ context.Context, variableID uuid.UUID, data api.VariableUpdate) error {
+	if c.failing[data.Name] {
+		return errFakeVariablesClient
+	}
+
+	variable := c.variables[variableID]
+	variable.Tags = data.Tags
+	c.variables[variableID] = variable
+
+	return nil
+}
+
+func (c *fakeVariablesClient) Delete(_ context.Context, variableID uuid.UUID) error {
+	if c.failing[c.variables[variableID].Name] {
+		return errFakeVariablesClient
+	}
+
+	delete(c.variables, variableID)
+
+	return nil
+}
+
+func TestReconcileVariablesPartialFailureHelper(t *testing.T) {
+	t.Parallel()
+
+	client := newFakeVariablesClient("failed-update", "failed-delete", "failed-create")
+
+	current := map[string]managedVariable{
+		"updated":       {ID: client.add("updated", `"old"`), Value: `"old"`},
+		"unchanged":     {ID: client.add("unchanged", `{"a":1}`), Value: `{"a":1}`},
+		"deleted":       {ID: client.add("deleted", `"value"`), Value: `"value"`},
+		"failed-update": {ID: client.add("failed-update", `"old"`), Value: `"old"`},
+		"failed-delete": {ID: client.add("failed-delete", `"value"`), Value: `"value"`},
+	}
+	desired := map[string]string{
+		"updated":       `"new"`,
+		"unchanged":     `{ "a": 1 }`,
+		"failed-update": `"new"`,
+		"created":       `[1, 2]`,
+		"failed-create": `"value"`,
+		"invalid":       `not json`,
+	}
+
+	result, diags := reconcileVariables(context.Background(), client, current, desired, []string{"team:data"})
+
+	// Only the operations that succeeded are reflected in the result.
+	want := map[string]string{
+		"updated":       `"new"`,
+		"unchanged":     `{"a":1}`,
+		"failed-update": `"old"`,
+		"failed-delete": `"value"`,
+		"created":       `[1, 2]`,
+	}
+	if len(result) != len(want) {
+		t.Fatalf("expected variables %v, got %v", want, result)
+	}
+	for name, value := range want {
+		variable, ok := result[name]
+		if !ok || variable.Value != value {
+			t.Fatalf("expected variable %q to have value %s, got %v", name, value, result)
+		}
+		if _, err := client.Get(context.Background(), uuid.MustParse(variable.ID)); err != nil {
+			t.Fatalf("expected variable %q to exist in the API: %s", name, err)
+		}
+	}
+	if result["failed-update"].ID != current["failed-update"].ID {
+		t.Fatalf("expected the variable that failed to update to keep its ID")
+	}
+
+	// Every failed variable gets its own diagnostic naming it.
+	failed := []string{`delete Variable "failed-delete"`, `create Variable "failed-create"`, `update Variable "failed-update"`, `create Variable "invalid"`}
+	if diags.ErrorsCount() != len(failed) {
+		t.Fatalf("expected %d errors, got %v", len(failed), diags)
+	}
+	for _, summary := range failed {
+		found := false
+		for _, d := range diags.Errors() {
+			if strings.HasSuffix(d.Summary(), summary) {
+				found = true
+			}
+		}
+		if !found {
+			t.Fatalf("expected an error for %s, got %v", summary, diags)
+		}
+	}
+}
@@ -0,0 +1,293 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccVariablesResource(workspace, workspaceName string, variables map[string]string) string {
+	tmpl := `
+{{.Workspace}}
+resource "prefect_variables" "variables" {
+	variables = {
+	{{- range $name, $value := .Variables}}
+		"{{$name}}" = jsonencode("{{$value}}")
+	{{- end}}
+	}
+	workspace_id = prefect_workspace.{{.WorkspaceName}}.id
+	depends_on = [prefect_workspace.{{.WorkspaceName}}]
+}
+`
+
+	return helpers.RenderTemplate(tmpl, struct {
+		Workspace     string
+		WorkspaceName string
+		Variables     map[string]string
+	}{
+		Workspace:     workspace,
+		WorkspaceName: workspaceName,
+		Variables:     variables,
+	})
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_variables(t *testing.T) {
+	resourceName := "prefect_variables.variables"
+
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+	workspaceResourceName := "prefect_workspace." + workspaceName
+
+	nameA := testutils.NewRandomPrefixedString()
+	nameB := testutils.NewRandomPrefixedString()
+	nameC := testutils.NewRandomPrefixedString()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check creation of multiple variables
+				Config: fixtureAccVariablesResource(workspace, workspaceName, map[string]string{nameA: "value-a", nameB: "value-b"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "variables.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "variables."+nameA, `"value-a"`),
+					resource.TestCheckResourceAttr(resourceName, "variables."+nameB, `"value-b"`),
+					resource.TestCheckResourceAttr(resourceName, "variable_ids.%", "2"),
+					testAccCheckManagedVariableValue(resourceName, workspaceResourceName, nameA, "value-a"),
+					testAccCheckManagedVariableValue(resourceName, workspaceResourceName, nameB, "value-b"),
+				),
+			},
+			{
+				// Check changing one value, removing one key and adding another
+				Config: fixtureAccVariablesResource(workspace, workspaceName, map[string]string{nameA: "value-a2", nameC: "value-c"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "variables.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "variables."+nameA, `"value-a2"`),
+					resource.TestCheckResourceAttr(resourceName, "variables."+nameC, `"value-c"`),
+					resource.TestCheckNoResourceAttr(resourceName, "variables."+nameB),
+					resource.TestCheckResourceAttr(resourceName, "variable_ids.%", "2"),
+					testAccCheckManagedVariableValue(resourceName, workspaceResourceName, nameA, "value-a2"),
+					testAccCheckManagedVariableValue(resourceName, workspaceResourceName, nameC, "value-c"),
+					testAccCheckVariableNameDeleted(workspaceResourceName, nameB),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckManagedVariableValue is a Custom Check Function that verifies
+// that a variable managed by prefect_variables has the expected value in the API.
+func testAccCheckManagedVariableValue(resourceName, workspaceResourceName, name, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		variablesResource, exists := s.RootModule().Resources[resourceName]
+		if !exists {
+			return fmt.Errorf("resource not found in state: %s", resourceName)
+		}
+		variableID, err := uuid.Parse(variablesResource.Primary.Attributes["variable_ids."+name])
+		if err != nil {
+			return fmt.Errorf("error parsing ID of variable %s: %w", name, err)
+		}
+
+		workspaceResource, exists := s.RootModule().Resources[workspaceResourceName]
+		if !exists {
+			return fmt.Errorf("resource not found in state: %s", workspaceResourceName)
+		}
+		workspaceID, _ := uuid.Parse(workspaceResource.Primary.ID)
+
+		c, _ := testutils.NewTestClient()
+		variablesClient, _ := c.Variables(uuid.Nil, workspaceID)
+
+		variable, err := variablesClient.Get(context.Background(), variableID)
+		if err != nil {
+			return fmt.Errorf("error fetching variable %s: %w", name, err)
+		}
+		if variable.Value != value {
+			return fmt.Errorf("expected variable %s to have value %s, got %s", name, value, variable.Value)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckVariableNameDeleted is a Custom Check Function that verifies
+// that a variable no longer exists in the API.
+func testAccCheckVariableNameDeleted(workspaceResourceName, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		workspaceResource, exists := s.RootModule().Resources[workspaceResourceName]
+		if !exists {
+			return fmt.Errorf("resource not found in state: %s", workspaceResourceName)
+		}
+		workspaceID, _ := uuid.Parse(workspaceResource.Primary.ID)
+
+		c, _ := testutils.NewTestClient()
+		variablesClient, _ := c.Variables(uuid.Nil, workspaceID)
+
+		if _, err := variablesClient.GetByName(context.Background(), name); err == nil {
+			return fmt.Errorf("expected variable %s to be deleted", name)
+		}
+
+		return nil
+	}
+}
+
+func fixtureAccVariablesDefaultTagsMock(endpoint string) string {
+	return fmt.Sprintf(`
+provider "prefect" {
+	endpoint = "%s"
+	default_tags = {
+		team = "data"
+	}
+}
+
+resource "prefect_variables" "variables" {
+	variables = {
+		region = jsonencode("eu-west")
+	}
+}
+`, endpoint)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_variables_default_tags(t *testing.T) {
+	variableID := uuid.New()
+
+	// The server records the tags the variable was created with.
+	var createdTags []string
+	var mutex sync.Mutex
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		switch {
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/variables/"):
+			var payload api.VariableCreate
+			_ = json.NewDecoder(r.Body).Decode(&payload)
+			createdTags = payload.Tags
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/variables/"+variableID.String()):
+		case r.Method == http.MethodDelete && strings.HasSuffix(r.URL.Path, "/variables/"+variableID.String()):
+			w.WriteHeader(http.StatusNoContent)
+
+			return
+		default:
+			http.NotFound(w, r)
+
+			return
+		}
+
+		_, _ = fmt.Fprintf(w, `{"id": %q, "name": "region", "value": "eu-west", "tags": ["team:data"]}`, variableID)
+	}))
+	defer server.Close()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Check that the provider's default tags are applied to the variables
+				Config: fixtureAccVariablesDefaultTagsMock(server.URL),
+				Check: func(_ *terraform.State) error {
+					mutex.Lock()
+					defer mutex.Unlock()
+
+					if !slices.Equal(createdTags, []string{"team:data"}) {
+						return fmt.Errorf("expected the variable to be created with the default tags, got: %v", createdTags)
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func fixtureAccVariablesMock(endpoint string) string {
+	return fmt.Sprintf(`
+provider "prefect" {
+	endpoint = "%s"
+}
+
+resource "prefect_variables" "variables" {
+	variables = {
+		region = jsonencode("eu-west")
+	}
+}
+`, endpoint)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_variables_deleted_outside_terraform(t *testing.T) {
+	// The server creates a new variable ID for every create, and responds
+	// with a 404 for variables deleted outside of Terraform.
+	var variableID uuid.UUID
+	var created int
+	deleted := map[string]bool{}
+	var mutex sync.Mutex
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		switch {
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/variables/"):
+			variableID = uuid.New()
+			created++
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/variables/"+variableID.String()) && !deleted[variableID.String()]:
+		case r.Method == http.MethodDelete && strings.HasSuffix(r.URL.Path, "/variables/"+variableID.String()):
+			w.WriteHeader(http.StatusNoContent)
+
+			return
+		default:
+			http.NotFound(w, r)
+
+			return
+		}
+
+		_, _ = fmt.Fprintf(w, `{"id": %q, "name": "region", "value": "eu-west", "tags": []}`, variableID)
+	}))
+	defer server.Close()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccVariablesMock(server.URL),
+			},
+			{
+				// Check that a variable deleted outside of Terraform is created again
+				PreConfig: func() {
+					mutex.Lock()
+					defer mutex.Unlock()
+
+					deleted[variableID.String()] = true
+				},
+				Config: fixtureAccVariablesMock(server.URL),
+				Check: func(_ *terraform.State) error {
+					mutex.Lock()
+					defer mutex.Unlock()
+
+					if created != 2 {
+						return fmt.Errorf("expected the deleted variable to be created again, got %d creates", created)
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,352 @@
+package resources
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var (
+	_ = resource.ResourceWithConfigure(&WebhookResource{})
+	_ = resource.ResourceWithImportState(&WebhookResource{})
+)
+
+// WebhookResource contains state for the resource.
+type WebhookResource struct {
+	client api.PrefectClient
+}
+
+// WebhookResourceModel defines the Terraform resource model.
+type WebhookResourceModel struct {
+	ID          types.String               `tfsdk:"id"`
+	Created     customtypes.TimestampValue `tfsdk:"created"`
+	Updated     customtypes.TimestampValue `tfsdk:"updated"`
+	AccountID   customtypes.UUIDValue      `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue      `tfsdk:"workspace_id"`
+
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Template    types.String `tfsdk:"template"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Slug        types.String `tfsdk:"slug"`
+	Endpoint    types.String `tfsdk:"endpoint"`
+}
+
+// NewWebhookResource returns a new WebhookResource.
+//
+//nolint:ireturn // required by Terraform API
+func NewWebhookResource() resource.Resource {
+	return &WebhookResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *WebhookResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook"
+}
+
+// Configure initializes runtime state for the resource.
+func (r *WebhookResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*helpers.ProviderData)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("resource", req.ProviderData))
+
+		return
+	}
+
+	r.client = data.Client
+}
+
+// Schema defines the schema for the resource.
+func (r *WebhookResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The resource `webhook` represents a Prefect Cloud Webhook. " +
+			"Webhooks turn HTTP requests to their endpoint into Prefect events, which can then trigger automations.",
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				// We cannot use a CustomType due to a conflict with PlanModifiers; see
+				// https://github.com/hashicorp/terraform-plugin-framework/issues/763
+				// https://github.com/hashicorp/terraform-plugin-framework/issues/754
+				Description: "Webhook ID (UUID)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was created (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was updated (RFC3339)",
+			},
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the webhook",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the webhook",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"template": schema.StringAttribute{
+				Description: "Jinja template used to build the Prefect event from the incoming request",
+				Required:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the webhook accepts incoming requests",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"slug": schema.StringAttribute{
+				Computed:    true,
+				Description: "Generated slug identifying the webhook in its endpoint URL",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"endpoint": schema.StringAttribute{
+				Computed:    true,
+				Description: "URL that requests are sent to in order to emit events",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// copyWebhookToModel maps an API response to a model that is saved in Terraform state.
+func copyWebhookToModel(webhook *api.Webhook, endpoint string, tfModel *WebhookResourceModel) {
+	tfModel.ID = types.StringValue(webhook.ID.String())
+	tfModel.Created = customtypes.NewTimestampPointerValue(webhook.Created)
+	tfModel.Updated = customtypes.NewTimestampPointerValue(webhook.Updated)
+
+	tfModel.Name = types.StringValue(webhook.Name)
+	tfModel.Description = types.StringValue(webhook.Description)
+	tfModel.Template = types.StringValue(webhook.Template)
+	tfModel.Enabled = types.BoolValue(webhook.Enabled)
+	tfModel.Slug = types.StringValue(webhook.Slug)
+	tfModel.Endpoint = types.StringValue(endpoint)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *WebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan WebhookResourceModel
+
+	// Populate the model from resource configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.Webhooks(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Webhook", err))
+
+		return
+	}
+
+	webhook, err := client.Create(ctx, api.WebhookCreate{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		Template:    plan.Template.ValueString(),
+		Enabled:     plan.Enabled.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Webhook", "create", err))
+
+		return
+	}
+
+	copyWebhookToModel(webhook, client.EndpointURL(webhook.Slug), &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *WebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state WebhookResourceModel
+
+	// Populate the model from state and emit diagnostics on error
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.Webhooks(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Webhook", err))
+
+		return
+	}
+
+	webhookID, err := uuid.Parse(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ParseUUIDErrorDiagnostic("Webhook", err))
+
+		return
+	}
+
+	webhook, err := client.Get(ctx, webhookID)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Webhook", "get", err))
+
+		return
+	}
+
+	copyWebhookToModel(webhook, client.EndpointURL(webhook.Slug), &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *WebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan WebhookResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.Webhooks(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Webhook", err))
+
+		return
+	}
+
+	webhookID, err := uuid.Parse(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ParseUUIDErrorDiagnostic("Webhook", err))
+
+		return
+	}
+
+	err = client.Update(ctx, webhookID, api.WebhookUpdate{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		Template:    plan.Template.ValueString(),
+		Enabled:     plan.Enabled.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Webhook", "update", err))
+
+		return
+	}
+
+	webhook, err := client.Get(ctx, webhookID)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Webhook", "get", err))
+
+		return
+	}
+
+	copyWebhookToModel(webhook, client.EndpointURL(webhook.Slug), &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *WebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state WebhookResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.Webhooks(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Webhook", err))
+
+		return
+	}
+
+	webhookID, err := uuid.Parse(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ParseUUIDErrorDiagnostic("Webhook", err))
+
+		return
+	}
+
+	err = client.Delete(ctx, webhookID)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Webhook", "delete", err))
+
+		return
+	}
+}
+
+// ImportState imports the resource into Terraform state.
+// Valid import IDs:
+// <webhook_id>
+// <webhook_id>,<workspace_id>.
+func (r *WebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ",")
+
+	if len(parts) > 2 || len(parts) == 0 {
+		resp.Diagnostics.AddError(
+			"Error importing webhook",
+			"Import ID must be in the format of <webhook_id> OR <webhook_id>,<workspace_id>",
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[0])...)
+
+	if len(parts) == 2 && parts[1] != "" {
+		workspaceID, err := uuid.Parse(parts[1])
+		if err != nil {
+			resp.Diagnostics.Append(helpers.ParseUUIDErrorDiagnostic("Workspace", err))
+
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), workspaceID.String())...)
+	}
+}
@@ -0,0 +1,127 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccWebhook(workspace, workspaceName, name, description string, enabled bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "prefect_webhook" "%s" {
+	name = "%s"
+	description = "%s"
+	enabled = %t
+	template = jsonencode({
+		event = "terraform.acceptance.test"
+		resource = {
+			"prefect.resource.id" = "terraform.acceptance.test"
+		}
+	})
+	workspace_id = prefect_workspace.%s.id
+}
+`, workspace, name, name, description, enabled, workspaceName)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_webhook(t *testing.T) {
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+	workspaceResourceName := "prefect_workspace." + workspaceName
+	randomName := testutils.NewRandomPrefixedString()
+	resourceName := "prefect_webhook." + randomName
+
+	var webhook api.Webhook
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccWebhook(workspace, workspaceName, randomName, "My webhook", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWebhookExists(resourceName, workspaceResourceName, &webhook),
+					resource.TestCheckResourceAttr(resourceName, "name", randomName),
+					resource.TestCheckResourceAttr(resourceName, "description", "My webhook"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "slug"),
+					testAccCheckWebhookEndpoint(resourceName),
+				),
+			},
+			{
+				// Check that the webhook is updated in place, keeping its slug
+				Config: fixtureAccWebhook(workspace, workspaceName, randomName, "My webhook v2", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWebhookExists(resourceName, workspaceResourceName, &webhook),
+					resource.TestCheckResourceAttr(resourceName, "description", "My webhook v2"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					func(_ *terraform.State) error {
+						if webhook.Enabled {
+							return fmt.Errorf("expected webhook to be disabled")
+						}
+
+						return nil
+					},
+					testAccCheckWebhookEndpoint(resourceName),
+				),
+			},
+			// Import State checks - import by ID (default)
+			{
+				ImportState:       true,
+				ImportStateIdFunc: helpers.GetResourceWorkspaceImportStateID(resourceName, workspaceResourceName),
+				ResourceName:      resourceName,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckWebhookExists(webhookResourceName string, workspaceResourceName string, webhook *api.Webhook) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		webhookResource, exists := state.RootModule().Resources[webhookResourceName]
+		if !exists {
+			return fmt.Errorf("Resource not found in state: %s", webhookResourceName)
+		}
+		webhookID, _ := uuid.Parse(webhookResource.Primary.ID)
+
+		workspaceResource, exists := state.RootModule().Resources[workspaceResourceName]
+		if !exists {
+			return fmt.Errorf("Resource not found in state: %s", workspaceResourceName)
+		}
+		workspaceID, _ := uuid.Parse(workspaceResource.Primary.ID)
+
+		// Create a new client, and use the default configurations from the environment
+		c, _ := testutils.NewTestClient()
+		webhooksClient, _ := c.Webhooks(uuid.Nil, workspaceID)
+
+		fetchedWebhook, err := webhooksClient.Get(context.Background(), webhookID)
+		if err != nil {
+			return fmt.Errorf("Error fetching webhook: %w", err)
+		}
+
+		*webhook = *fetchedWebhook
+
+		return nil
+	}
+}
+
+// testAccCheckWebhookEndpoint checks that the endpoint is the URL for the webhook's slug.
+func testAccCheckWebhookEndpoint(webhookResourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		attributes := state.RootModule().Resources[webhookResourceName].Primary.Attributes
+		if !strings.HasSuffix(attributes["endpoint"], "/hooks/"+attributes["slug"]) {
+			return fmt.Errorf("expected endpoint %q to end with the webhook slug %q", attributes["endpoint"], attributes["slug"])
+		}
+
+		return nil
+	}
+}
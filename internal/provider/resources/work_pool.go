@@ -2,10 +2,12 @@ package resources
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -45,6 +47,10 @@ type WorkPoolResourceModel struct {
 	ConcurrencyLimit types.Int64           `tfsdk:"concurrency_limit"`
 	DefaultQueueID   customtypes.UUIDValue `tfsdk:"default_queue_id"`
 	BaseJobTemplate  jsontypes.Normalized  `tfsdk:"base_job_template"`
+
+	BaseJobTemplateOverrides jsontypes.Normalized `tfsdk:"base_job_template_overrides"`
+	ResolvedBaseJobTemplate  jsontypes.Normalized `tfsdk:"resolved_base_job_template"`
+	JobVariablesSchema       jsontypes.Normalized `tfsdk:"job_variables_schema"`
 }
 
 // NewWorkPoolResource returns a new WorkPoolResource.
@@ -65,14 +71,14 @@ func (r *WorkPoolResource) Configure(_ context.Context, req resource.ConfigureRe
 		return
 	}
 
-	client, ok := req.ProviderData.(api.PrefectClient)
+	data, ok := req.ProviderData.(*helpers.ProviderData)
 	if !ok {
 		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("resource", req.ProviderData))
 
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
 }
 
 // Schema defines the schema for the resource.
@@ -166,16 +172,33 @@ func (r *WorkPoolResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Computed:    true,
 				CustomType:  jsontypes.NormalizedType{},
 				Default:     stringdefault.StaticString("{}"),
-				Description: "The base job template for the work pool, as a JSON string",
+				Description: "The base job template for the work pool, as a JSON string. A non-empty template must define both the `job_configuration` and `variables` keys; leave it empty to use the default template of the work pool type.",
+				Optional:    true,
+			},
+			"base_job_template_overrides": schema.StringAttribute{
+				CustomType:  jsontypes.NormalizedType{},
+				Description: "Values to deep-merge on top of `base_job_template`, as a JSON string. Nested objects are merged key by key, so this only needs the values that differ, e.g. a default image or namespace under `variables.properties`.",
 				Optional:    true,
 			},
+			"resolved_base_job_template": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  jsontypes.NormalizedType{},
+				Description: "The base job template sent to the API, after `base_job_template_overrides` is merged on top of `base_job_template`, as a JSON string",
+			},
+			"job_variables_schema": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  jsontypes.NormalizedType{},
+				Description: "The JSON schema of the job variables that deployments in this work pool can set (the `variables` section of the base job template), as a JSON string",
+			},
 		},
 	}
 }
 
 // copyWorkPoolToModel maps an API response to a model that is saved in Terraform state.
 // A model can be a Terraform Plan, State, or Config object.
-func copyWorkPoolToModel(pool *api.WorkPool, tfModel *WorkPoolResourceModel) {
+func copyWorkPoolToModel(pool *api.WorkPool, tfModel *WorkPoolResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
 	tfModel.ID = types.StringValue(pool.ID.String())
 	tfModel.Created = customtypes.NewTimestampPointerValue(pool.Created)
 	tfModel.Updated = customtypes.NewTimestampPointerValue(pool.Updated)
@@ -186,6 +209,72 @@ func copyWorkPoolToModel(pool *api.WorkPool, tfModel *WorkPoolResourceModel) {
 	tfModel.Name = types.StringValue(pool.Name)
 	tfModel.Paused = types.BoolValue(pool.IsPaused)
 	tfModel.Type = types.StringValue(pool.Type)
+
+	jobVariablesSchema, err := helpers.JobVariablesSchema(pool.BaseJobTemplate)
+	if err != nil {
+		diags.Append(helpers.SerializeDataErrorDiagnostic("job_variables_schema", "Work Pool job variables schema", err))
+
+		return diags
+	}
+	tfModel.JobVariablesSchema = jobVariablesSchema
+
+	return diags
+}
+
+// resolveBaseJobTemplate merges the configured overrides on top of the
+// base job template and validates the result, returning the template
+// to send to the API.
+func resolveBaseJobTemplate(model *WorkPoolResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	baseJobTemplate := map[string]interface{}{}
+	diags.Append(model.BaseJobTemplate.Unmarshal(&baseJobTemplate)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if !model.BaseJobTemplateOverrides.IsNull() {
+		overrides := map[string]interface{}{}
+		diags.Append(model.BaseJobTemplateOverrides.Unmarshal(&overrides)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		baseJobTemplate = helpers.MergeObjects(baseJobTemplate, overrides)
+	}
+
+	// Catch malformed templates before they are sent, as the API accepts
+	// them and flow runs only fail once a worker picks them up.
+	if err := helpers.ValidateBaseJobTemplate(baseJobTemplate); err != nil {
+		diags.AddAttributeError(
+			path.Root("base_job_template"),
+			"Invalid base job template",
+			fmt.Sprintf("Could not use the base job template (including any `base_job_template_overrides`): %s. "+
+				"A template must define both `job_configuration` and `variables`, or be left empty to use the default template of the work pool type.", err.Error()),
+		)
+
+		return nil, diags
+	}
+
+	return baseJobTemplate, diags
+}
+
+// setResolvedBaseJobTemplate stores the template that was sent to the API.
+// We use the sent template rather than the one the API returns, since
+// the API masks any secret values embedded in it.
+func setResolvedBaseJobTemplate(model *WorkPoolResourceModel, baseJobTemplate map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	byteSlice, err := json.Marshal(baseJobTemplate)
+	if err != nil {
+		diags.Append(helpers.SerializeDataErrorDiagnostic("resolved_base_job_template", "Work Pool base job template", err))
+
+		return diags
+	}
+
+	model.ResolvedBaseJobTemplate = jsontypes.NewNormalizedValue(string(byteSlice))
+
+	return diags
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -198,8 +287,8 @@ func (r *WorkPoolResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	baseJobTemplate := map[string]interface{}{}
-	resp.Diagnostics.Append(plan.BaseJobTemplate.Unmarshal(&baseJobTemplate)...)
+	baseJobTemplate, diags := resolveBaseJobTemplate(&plan)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -225,7 +314,12 @@ func (r *WorkPoolResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	copyWorkPoolToModel(pool, &plan)
+	resp.Diagnostics.Append(copyWorkPoolToModel(pool, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setResolvedBaseJobTemplate(&plan, baseJobTemplate)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -260,7 +354,10 @@ func (r *WorkPoolResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	copyWorkPoolToModel(pool, &state)
+	resp.Diagnostics.Append(copyWorkPoolToModel(pool, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -277,8 +374,8 @@ func (r *WorkPoolResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	baseJobTemplate := map[string]interface{}{}
-	resp.Diagnostics.Append(plan.BaseJobTemplate.Unmarshal(&baseJobTemplate)...)
+	baseJobTemplate, diags := resolveBaseJobTemplate(&plan)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -315,15 +412,18 @@ func (r *WorkPoolResource) Update(ctx context.Context, req resource.UpdateReques
 	// of the base job template in the state. This avoids "inconsistent value"
 	// errors.
 	//
+	// Secret values embedded in the template (e.g. credentials) are masked
+	// by the API on read, so we'll compare against the planned values for those.
+	//
 	// If the two are not equal, then something has gone wrong so we should
 	// exit and alert the user of the differences.
-	equal, diffs := helpers.ObjectsEqual(baseJobTemplate, pool.BaseJobTemplate)
+	equal, diffs := helpers.ObjectsEqual(baseJobTemplate, helpers.RestoreMaskedValues(baseJobTemplate, pool.BaseJobTemplate))
 	if !equal {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("base_job_template"),
 			"Unexpected difference in base_job_templates",
 			fmt.Sprintf(
-				"Expected the provided base_job_template to be equal to the one retrieved from the API, differences: %s",
+				"Expected the resolved base_job_template to be equal to the one retrieved from the API, differences: %s",
 				strings.Join(diffs, "\n"),
 			),
 		)
@@ -331,7 +431,15 @@ func (r *WorkPoolResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	copyWorkPoolToModel(pool, &plan)
+	resp.Diagnostics.Append(copyWorkPoolToModel(pool, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setResolvedBaseJobTemplate(&plan, baseJobTemplate)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
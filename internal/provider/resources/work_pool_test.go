@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -118,12 +119,263 @@ func TestAccResource_work_pool(t *testing.T) {
 				ResourceName:            workPoolResourceName2,
 				ImportStateIdFunc:       getWorkPoolImportStateID(workPoolResourceName2, workspaceResourceName),
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"base_job_template"}, // we've already tested this, and we can't provide our unique equality check here
+				ImportStateVerifyIgnore: []string{"base_job_template", "resolved_base_job_template"}, // we've already tested this, and we can't provide our unique equality check here
 			},
 		},
 	})
 }
 
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_work_pool_masked_template(t *testing.T) {
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+	workspaceResourceName := "prefect_workspace." + workspaceName
+
+	randomName := testutils.NewRandomPrefixedString()
+	workPoolResourceName := "prefect_work_pool." + randomName
+
+	var workPool api.WorkPool
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check creation of a work pool whose template embeds a credential
+				Config: fixtureAccWorkPoolCreate(workspace, workspaceName, randomName, "process", fmt.Sprintf(baseJobTemplateWithSecretTpl, "secret-value-1"), false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkPoolExists(workPoolResourceName, workspaceResourceName, &workPool),
+					resource.TestCheckResourceAttr(workPoolResourceName, "name", randomName),
+				),
+			},
+			{
+				// Check that rotating the embedded credential updates the
+				// resource in place, even though the API masks it on read
+				Config: fixtureAccWorkPoolCreate(workspace, workspaceName, randomName, "process", fmt.Sprintf(baseJobTemplateWithSecretTpl, "secret-value-2"), false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIDAreEqual(workPoolResourceName, &workPool),
+					resource.TestCheckResourceAttr(workPoolResourceName, "name", randomName),
+				),
+			},
+		},
+	})
+}
+
+func fixtureAccWorkPoolOverrides(workspace, workspaceName, name, image, namespace string) string {
+	return fmt.Sprintf(`
+%s
+data "prefect_worker_metadata" "%s" {}
+
+resource "prefect_work_pool" "%s" {
+	name = "%s"
+	type = "kubernetes"
+	base_job_template = data.prefect_worker_metadata.%s.base_job_configs.kubernetes
+	base_job_template_overrides = jsonencode({
+		variables = {
+			properties = {
+				image = {
+					default = "%s"
+				}
+				namespace = {
+					default = "%s"
+				}
+			}
+		}
+	})
+	workspace_id = prefect_workspace.%s.id
+	depends_on = [prefect_workspace.%s]
+}
+`, workspace, name, name, name, name, image, namespace, workspaceName, workspaceName)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_work_pool_template_overrides(t *testing.T) {
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+	workspaceResourceName := "prefect_workspace." + workspaceName
+
+	randomName := testutils.NewRandomPrefixedString()
+	workPoolResourceName := "prefect_work_pool." + randomName
+
+	var workPool api.WorkPool
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check that the overrides are merged into the default kubernetes template
+				Config: fixtureAccWorkPoolOverrides(workspace, workspaceName, randomName, "prefecthq/prefect:3-latest", "prefect"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkPoolExists(workPoolResourceName, workspaceResourceName, &workPool),
+					testAccCheckWorkPoolVariableDefault(&workPool, "image", "prefecthq/prefect:3-latest"),
+					testAccCheckWorkPoolVariableDefault(&workPool, "namespace", "prefect"),
+					testAccCheckWorkPoolVariableExists(&workPool, "service_account_name"),
+					resource.TestCheckResourceAttrSet(workPoolResourceName, "resolved_base_job_template"),
+				),
+			},
+			{
+				// Check that changing an override updates the resolved template in place
+				Config: fixtureAccWorkPoolOverrides(workspace, workspaceName, randomName, "prefecthq/prefect:3-python3.12", "prefect"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkPoolExists(workPoolResourceName, workspaceResourceName, &workPool),
+					testAccCheckIDAreEqual(workPoolResourceName, &workPool),
+					testAccCheckWorkPoolVariableDefault(&workPool, "image", "prefecthq/prefect:3-python3.12"),
+					testAccCheckWorkPoolVariableDefault(&workPool, "namespace", "prefect"),
+				),
+			},
+		},
+	})
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_work_pool_job_variables_schema(t *testing.T) {
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+	randomName := testutils.NewRandomPrefixedString()
+	workPoolResourceName := "prefect_work_pool." + randomName
+
+	baseJobTemplate := fmt.Sprintf(baseJobTemplateTpl, "The name given to infrastructure created by a worker.")
+	var baseJobTemplateMap map[string]interface{}
+	_ = json.Unmarshal([]byte(baseJobTemplate), &baseJobTemplateMap)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccWorkPoolCreate(workspace, workspaceName, randomName, "kubernetes", baseJobTemplate, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobVariablesSchema(workPoolResourceName, baseJobTemplateMap["variables"]),
+				),
+			},
+		},
+	})
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_work_pool_invalid_template(t *testing.T) {
+	workspace, workspaceName := testutils.NewEphemeralWorkspace()
+	randomName := testutils.NewRandomPrefixedString()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// A template without a variables schema is rejected before it is sent
+				Config:      fixtureAccWorkPoolCreate(workspace, workspaceName, randomName, "kubernetes", `{"job_configuration" = {"image" = "prefecthq/prefect:3-latest"}}`, false),
+				ExpectError: regexp.MustCompile("missing the required `variables` key"),
+			},
+		},
+	})
+}
+
+func TestBaseJobTemplateValidationHelper(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		baseJobTemplate string
+		want            string
+	}{
+		{`{}`, ""},
+		{`{"job_configuration": {}, "variables": {"properties": {}}}`, ""},
+		{`{"variables": {}}`, "missing the required `job_configuration` key"},
+		{`{"job_configuration": {}}`, "missing the required `variables` key"},
+		{`{"job_configuration": "image", "variables": {}}`, "`job_configuration` key of the base job template must be an object, got string"},
+		{`{"job_configuration": {}, "variables": null}`, "`variables` key of the base job template must be an object, got null"},
+	}
+
+	for _, c := range cases {
+		var baseJobTemplate map[string]interface{}
+		if err := json.Unmarshal([]byte(c.baseJobTemplate), &baseJobTemplate); err != nil {
+			t.Fatalf("error decoding base job template: %s", err)
+		}
+
+		err := helpers.ValidateBaseJobTemplate(baseJobTemplate)
+		switch {
+		case c.want == "" && err != nil:
+			t.Fatalf("base job template %s should be valid, but got %s", c.baseJobTemplate, err)
+		case c.want != "" && (err == nil || !strings.Contains(err.Error(), c.want)):
+			t.Fatalf("base job template %s should be rejected with %q, but got %v", c.baseJobTemplate, c.want, err)
+		}
+	}
+}
+
+// testAccCheckJobVariablesSchema checks that the job_variables_schema in state
+// is equal to the expected variables schema, regardless of key order.
+func testAccCheckJobVariablesSchema(workPoolResourceName string, expected interface{}) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		workPoolResource, exists := s.RootModule().Resources[workPoolResourceName]
+		if !exists {
+			return fmt.Errorf("Resource not found in state: %s", workPoolResourceName)
+		}
+
+		var jobVariablesSchema interface{}
+		if err := json.Unmarshal([]byte(workPoolResource.Primary.Attributes["job_variables_schema"]), &jobVariablesSchema); err != nil {
+			return fmt.Errorf("error parsing job_variables_schema: %w", err)
+		}
+
+		if equal, diffs := helpers.ObjectsEqual(expected, jobVariablesSchema); !equal {
+			return fmt.Errorf("job_variables_schema differs from the base job template variables: %s", strings.Join(diffs, "\n"))
+		}
+
+		return nil
+	}
+}
+
+// workPoolVariableProperties returns the variables.properties section
+// of a work pool's base job template.
+func workPoolVariableProperties(workPool *api.WorkPool) (map[string]interface{}, error) {
+	variables, ok := workPool.BaseJobTemplate["variables"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("base_job_template has no variables section")
+	}
+
+	properties, ok := variables["properties"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("base_job_template has no variables.properties section")
+	}
+
+	return properties, nil
+}
+
+// testAccCheckWorkPoolVariableDefault checks the default value of a
+// variable in the work pool's base job template.
+func testAccCheckWorkPoolVariableDefault(workPool *api.WorkPool, variable, expected string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		properties, err := workPoolVariableProperties(workPool)
+		if err != nil {
+			return err
+		}
+
+		property, ok := properties[variable].(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("base_job_template has no %q variable", variable)
+		}
+
+		if property["default"] != expected {
+			return fmt.Errorf("expected %q variable default to be %q, got %v", variable, expected, property["default"])
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckWorkPoolVariableExists checks that a variable from the
+// default template survived the merge.
+func testAccCheckWorkPoolVariableExists(workPool *api.WorkPool, variable string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		properties, err := workPoolVariableProperties(workPool)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := properties[variable]; !ok {
+			return fmt.Errorf("base_job_template has no %q variable", variable)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckWorkPoolExists(workPoolResourceName string, workspaceResourceName string, workPool *api.WorkPool) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		workPoolResource, exists := state.RootModule().Resources[workPoolResourceName]
@@ -287,3 +539,36 @@ var baseJobTemplateTpl = `
   }
 }
 `
+
+var baseJobTemplateWithSecretTpl = `
+{
+  "job_configuration": {
+    "command": "{{ command }}",
+    "env": "{{ env }}",
+    "api_token": "{{ api_token }}"
+  },
+  "variables": {
+    "type": "object",
+    "properties": {
+      "command": {
+        "title": "Command",
+        "type": "string"
+      },
+      "env": {
+        "title": "Environment Variables",
+        "type": "object",
+        "additionalProperties": {
+          "type": "string"
+        }
+      },
+      "api_token": {
+        "title": "API Token",
+        "type": "string",
+        "format": "password",
+        "writeOnly": true,
+        "default": "%s"
+      }
+    }
+  }
+}
+`
@@ -3,7 +3,10 @@ package resources
 import (
 	"context"
 
+	"fmt"
+
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -11,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
@@ -36,6 +40,7 @@ type WorkspaceRoleResourceModel struct {
 	Name            types.String          `tfsdk:"name"`
 	Description     types.String          `tfsdk:"description"`
 	Scopes          types.List            `tfsdk:"scopes"`
+	Permissions     types.Set             `tfsdk:"permissions"`
 	AccountID       customtypes.UUIDValue `tfsdk:"account_id"`
 	InheritedRoleID customtypes.UUIDValue `tfsdk:"inherited_role_id"`
 }
@@ -58,14 +63,14 @@ func (r *WorkspaceRoleResource) Configure(_ context.Context, req resource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(api.PrefectClient)
+	data, ok := req.ProviderData.(*helpers.ProviderData)
 	if !ok {
 		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("resource", req.ProviderData))
 
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
 }
 
 func (r *WorkspaceRoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -75,6 +80,8 @@ func (r *WorkspaceRoleResource) Schema(_ context.Context, _ resource.SchemaReque
 			"Workspace Roles hold a set of permissions to a specific Workspace, and can be attached to " +
 			"an accessor (User or Service Account) to grant access to the Workspace.\n" +
 			"\n" +
+			"Built-in Workspace Roles can be imported to reference them, but they can't be updated or deleted.\n" +
+			"\n" +
 			"To obtain a list of available scopes, please refer to the `GET /api/workspace_scopes` " +
 			"[API](https://app.prefect.cloud/api/docs#tag/Workspace-Scopes/operation/get_workspace_scopes_api_workspace_scopes_get)",
 		Version: 0,
@@ -114,6 +121,16 @@ func (r *WorkspaceRoleResource) Schema(_ context.Context, _ resource.SchemaReque
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"permissions": schema.SetAttribute{
+				Description: "Set of permissions (scopes) linked to the Workspace Role. Unlike `scopes`, their order doesn't matter, " +
+					"so a change is planned as the permissions added and removed. Read from the API when importing a built-in Workspace Role. Conflicts with `scopes`.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("scopes")),
+				},
+			},
 			"account_id": schema.StringAttribute{
 				CustomType:  customtypes.UUIDType{},
 				Description: "Account ID (UUID), defaults to the account set in the provider",
@@ -158,6 +175,31 @@ func copyWorkspaceRoleToModel(_ context.Context, role *api.WorkspaceRole, tfMode
 	return nil
 }
 
+// workspaceRoleScopes returns the scopes to send to the API, from
+// `permissions` or `scopes`, whichever is set.
+func workspaceRoleScopes(ctx context.Context, model *WorkspaceRoleResourceModel) ([]string, diag.Diagnostics) {
+	var scopes []string
+	if !model.Permissions.IsNull() && !model.Permissions.IsUnknown() {
+		diags := model.Permissions.ElementsAs(ctx, &scopes, false)
+
+		return scopes, diags
+	}
+
+	diags := model.Scopes.ElementsAs(ctx, &scopes, false)
+
+	return scopes, diags
+}
+
+// builtInWorkspaceRoleDiagnostic returns the error for modifying one of the
+// built-in Workspace Roles, which don't belong to an account.
+func builtInWorkspaceRoleDiagnostic(name, operation string) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Built-in Workspace Role can't be modified",
+		fmt.Sprintf("Could not %s Workspace Role %q, as it's built into Prefect Cloud. "+
+			"To stop managing it, remove it from the state instead, e.g. with a `removed` block.", operation, name),
+	)
+}
+
 func (r *WorkspaceRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan WorkspaceRoleResourceModel
 
@@ -167,8 +209,8 @@ func (r *WorkspaceRoleResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	var scopes []string
-	resp.Diagnostics.Append(plan.Scopes.ElementsAs(ctx, &scopes, false)...)
+	scopes, diags := workspaceRoleScopes(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -197,6 +239,12 @@ func (r *WorkspaceRoleResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	// Like scopes, permissions are kept as configured rather than as
+	// returned by the API, so they're left unset when not configured.
+	if plan.Permissions.IsUnknown() {
+		plan.Permissions = types.SetNull(types.StringType)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -239,6 +287,17 @@ func (r *WorkspaceRoleResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	// An imported built-in role has no configured scopes to keep, and
+	// can't be updated, so its permissions are taken as they are.
+	if role.AccountID == nil && state.Scopes.IsNull() && state.Permissions.IsNull() {
+		permissions, diags := types.SetValueFrom(ctx, types.StringType, role.Scopes)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Permissions = permissions
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -247,13 +306,20 @@ func (r *WorkspaceRoleResource) Read(ctx context.Context, req resource.ReadReque
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *WorkspaceRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan WorkspaceRoleResourceModel
+	var plan, state WorkspaceRoleResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if state.AccountID.IsNull() {
+		resp.Diagnostics.Append(builtInWorkspaceRoleDiagnostic(state.Name.ValueString(), "update"))
+
+		return
+	}
+
 	client, err := r.client.WorkspaceRoles(plan.AccountID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Workspace Role", err))
@@ -261,8 +327,8 @@ func (r *WorkspaceRoleResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	var scopes []string
-	resp.Diagnostics.Append(plan.Scopes.ElementsAs(ctx, &scopes, false)...)
+	scopes, diags := workspaceRoleScopes(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -298,6 +364,12 @@ func (r *WorkspaceRoleResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	// Like scopes, permissions are kept as configured rather than as
+	// returned by the API, so they're left unset when not configured.
+	if plan.Permissions.IsUnknown() {
+		plan.Permissions = types.SetNull(types.StringType)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -313,6 +385,12 @@ func (r *WorkspaceRoleResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	if state.AccountID.IsNull() {
+		resp.Diagnostics.Append(builtInWorkspaceRoleDiagnostic(state.Name.ValueString(), "delete"))
+
+		return
+	}
+
 	client, err := r.client.WorkspaceRoles(state.AccountID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Workspace Role`", err))
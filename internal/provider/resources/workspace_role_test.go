@@ -3,8 +3,12 @@ package resources_test
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
+	"regexp"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
@@ -84,6 +88,142 @@ func TestAccResource_workspace_role(t *testing.T) {
 	})
 }
 
+func fixtureAccWorkspaceRolePermissions(name string, permissions string) string {
+	return fmt.Sprintf(`
+resource "prefect_workspace_role" "role" {
+	name = "%s"
+	permissions = [%s]
+}`, name, permissions)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_workspace_role_permissions(t *testing.T) {
+	resourceName := "prefect_workspace_role.role"
+	randomName := testutils.TestAccPrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	var workspaceRole api.WorkspaceRole
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccWorkspaceRolePermissions(randomName, `"see_blocks", "see_artifacts"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkspaceRoleExists(resourceName, &workspaceRole),
+					testAccCheckWorkspaceRoleValues(&workspaceRole, &api.WorkspaceRole{Name: randomName, Scopes: []string{"see_artifacts", "see_blocks"}}),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", "see_blocks"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", "see_artifacts"),
+				),
+			},
+			{
+				// Reordering the permissions isn't a change
+				Config:   fixtureAccWorkspaceRolePermissions(randomName, `"see_artifacts", "see_blocks"`),
+				PlanOnly: true,
+			},
+			{
+				Config: fixtureAccWorkspaceRolePermissions(randomName, `"see_artifacts", "see_variables"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkspaceRoleExists(resourceName, &workspaceRole),
+					testAccCheckWorkspaceRoleValues(&workspaceRole, &api.WorkspaceRole{Name: randomName, Scopes: []string{"see_artifacts", "see_variables"}}),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", "see_artifacts"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", "see_variables"),
+				),
+			},
+		},
+	})
+}
+
+func fixtureAccWorkspaceRoleBuiltInMock(endpoint, block string) string {
+	return fmt.Sprintf(`
+provider "prefect" {
+	endpoint = "%s"
+}
+%s
+`, endpoint, block)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_workspace_role_built_in(t *testing.T) {
+	resourceName := "prefect_workspace_role.viewer"
+	rolePath := "/workspace_roles/7d2b3b8e-5f0a-4f0e-9c7e-2b1c0d3e4f5a"
+
+	// The server only knows a built-in role, which has no account.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || !strings.HasSuffix(r.URL.Path, rolePath) {
+			http.Error(w, "built-in roles can't be modified", http.StatusForbidden)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+"id": "7d2b3b8e-5f0a-4f0e-9c7e-2b1c0d3e4f5a",
+"name": "Viewer",
+"description": "Can see everything in a workspace",
+"scopes": ["see_blocks", "see_flows"],
+"account_id": null,
+"inherited_role_id": null
+}`))
+	}))
+	defer server.Close()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Import the built-in role, which fills in its permissions
+				Config: fixtureAccWorkspaceRoleBuiltInMock(server.URL, `
+resource "prefect_workspace_role" "viewer" {
+	name = "Viewer"
+	description = "Can see everything in a workspace"
+	permissions = ["see_flows", "see_blocks"]
+}`),
+				ResourceName:       resourceName,
+				ImportState:        true,
+				ImportStateId:      "7d2b3b8e-5f0a-4f0e-9c7e-2b1c0d3e4f5a",
+				ImportStatePersist: true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported Workspace Role, got: %d", len(states))
+					}
+					if states[0].Attributes["permissions.#"] != "2" {
+						return fmt.Errorf("expected 2 permissions, got: %s", states[0].Attributes["permissions.#"])
+					}
+					if states[0].Attributes["account_id"] != "" {
+						return fmt.Errorf("expected no account_id, got: %s", states[0].Attributes["account_id"])
+					}
+
+					return nil
+				},
+			},
+			{
+				// Changing the built-in role fails
+				Config: fixtureAccWorkspaceRoleBuiltInMock(server.URL, `
+resource "prefect_workspace_role" "viewer" {
+	name = "Viewer"
+	description = "Can see blocks in a workspace"
+	permissions = ["see_blocks"]
+}`),
+				ExpectError: regexp.MustCompile("Built-in Workspace Role can't be modified"),
+			},
+			{
+				// It can be removed from the state without deleting it
+				Config: fixtureAccWorkspaceRoleBuiltInMock(server.URL, `
+removed {
+	from = prefect_workspace_role.viewer
+
+	lifecycle {
+		destroy = false
+	}
+}`),
+			},
+		},
+	})
+}
+
 func testAccCheckWorkspaceRoleExists(roleResourceName string, role *api.WorkspaceRole) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		workspaceRoleResource, ok := state.RootModule().Resources[roleResourceName]
@@ -15,7 +15,19 @@ type PrefectProvider struct {
 // PrefectProviderModel maps provider schema data to a Go type.
 type PrefectProviderModel struct {
 	Endpoint    types.String          `tfsdk:"endpoint"`
+	APIPrefix   types.String          `tfsdk:"api_prefix"`
 	APIKey      types.String          `tfsdk:"api_key"`
+	APIKeyFile  types.String          `tfsdk:"api_key_file"`
 	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
 	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+
+	HTTPHeaders types.Map `tfsdk:"http_headers"`
+
+	DefaultPausedByWorkspace           types.Map  `tfsdk:"default_paused_by_workspace"`
+	DefaultTags                        types.Map  `tfsdk:"default_tags"`
+	EnforceParameterSchemaWhenProvided types.Bool `tfsdk:"enforce_parameter_schema_when_provided"`
+
+	RateLimit                   types.Float64 `tfsdk:"rate_limit"`
+	RequestTimeout              types.Int64   `tfsdk:"request_timeout"`
+	APIKeyExpirationWarningDays types.Int64   `tfsdk:"api_key_expiration_warning_days"`
 }